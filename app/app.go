@@ -2,12 +2,14 @@ package app
 
 import (
 	"fmt"
+	"path/filepath"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
@@ -20,6 +22,7 @@ import (
 	"github.com/maticnetwork/heimdall/bor"
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
+	chainmanagerClient "github.com/maticnetwork/heimdall/chainmanager/client"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
@@ -45,6 +48,9 @@ import (
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeClient "github.com/maticnetwork/heimdall/upgrade/client"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -73,7 +79,13 @@ var (
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		upgrade.AppModuleBasic{},
+		gov.NewAppModuleBasic(
+			paramsClient.ProposalHandler,
+			upgradeClient.ProposalHandler,
+			upgradeClient.CancelProposalHandler,
+			chainmanagerClient.ProposalHandler,
+		),
 	)
 
 	// module account permissions
@@ -111,6 +123,7 @@ type HeimdallApp struct {
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
 	SlashingKeeper    slashing.Keeper
+	UpgradeKeeper     upgrade.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -244,6 +257,16 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
 	app.subspaces[topupTypes.ModuleName] = app.ParamsKeeper.Subspace(topupTypes.DefaultParamspace)
 
+	// reject the param change proposals leaving invalid params
+	app.ParamsKeeper.RegisterValidation(checkpointTypes.DefaultParamspace, func() params.ValidatedParamSet {
+		milestoneParams := checkpointTypes.DefaultMilestoneParams()
+		return &milestoneParams
+	})
+	app.ParamsKeeper.RegisterValidation(borTypes.DefaultParamspace, func() params.ValidatedParamSet {
+		selectionParams := borTypes.DefaultSelectionParams()
+		return &selectionParams
+	})
+
 	//
 	// Contract caller
 	//
@@ -265,6 +288,13 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	// keepers
 	//
 
+	// create upgrade keeper, which keeps its state in the gov store
+	app.UpgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		keys[govTypes.StoreKey], // target store
+		upgradeTypes.DefaultCodespace,
+	)
+
 	// create side channel keeper
 	app.SidechannelKeeper = sidechannel.NewKeeper(
 		app.cdc,
@@ -280,6 +310,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[chainmanagerTypes.ModuleName],
 		common.DefaultCodespace,
 		app.caller,
+		app.UpgradeKeeper,
 	)
 
 	// account keeper
@@ -296,6 +327,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[stakingTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
 		moduleCommunicator,
 	)
 
@@ -331,8 +363,10 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
-		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(govTypes.RouterKey, gov.NewProposalHandler(app.UpgradeKeeper, app.ChainKeeper)).
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradeTypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(chainmanagerTypes.RouterKey, chainmanager.NewChainParamsMigrationProposalHandler(app.ChainKeeper))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -340,6 +374,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[govTypes.ModuleName],
 		app.SupplyKeeper,
 		app.StakingKeeper,
+		app.UpgradeKeeper,
 		govTypes.DefaultCodespace,
 		govRouter,
 	)
@@ -351,9 +386,28 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.StakingKeeper,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
 		moduleCommunicator,
 	)
 
+	if helper.GetConfig().MilestoneArchive {
+		milestoneArchive, err := checkpoint.OpenMilestoneArchive(app.cdc, filepath.Join(viper.GetString(helper.HomeFlag), "data"))
+		if err != nil {
+			cmn.Exit(err.Error())
+		}
+
+		app.CheckpointKeeper.SetMilestoneArchive(milestoneArchive)
+	}
+
+	if helper.GetConfig().CheckpointHistory {
+		checkpointHistory, err := checkpoint.OpenCheckpointHistory(app.cdc, filepath.Join(viper.GetString(helper.HomeFlag), "data"))
+		if err != nil {
+			cmn.Exit(err.Error())
+		}
+
+		app.CheckpointKeeper.SetCheckpointHistory(checkpointHistory)
+	}
+
 	app.BorKeeper = bor.NewKeeper(
 		app.cdc,
 		keys[borTypes.StoreKey], // target store
@@ -361,6 +415,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.ChainKeeper,
 		app.StakingKeeper,
+		app.UpgradeKeeper,
 		&app.caller,
 	)
 
@@ -370,6 +425,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[clerkTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.UpgradeKeeper,
 	)
 
 	// may be need signer
@@ -383,9 +439,15 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.StakingKeeper,
 	)
 
+	// upgrades known to this binary
+	app.setUpgradeHandlers()
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
+	// NOTE: The upgrade module must be the first one to begin the blocks, so that the
+	// upgrades are applied before the other modules run.
 	app.mm = module.NewManager(
+		upgrade.NewAppModule(app.UpgradeKeeper),
 		sidechannel.NewAppModule(app.SidechannelKeeper),
 		auth.NewAppModule(app.AccountKeeper, &app.caller, []authTypes.AccountProcessor{
 			supplyTypes.AccountProcessor,
@@ -409,6 +471,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		authTypes.ModuleName,
 		bankTypes.ModuleName,
 		govTypes.ModuleName,
+		upgradeTypes.ModuleName,
 		chainmanagerTypes.ModuleName,
 		supplyTypes.ModuleName,
 		stakingTypes.ModuleName,
@@ -467,6 +530,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		auth.NewAnteHandler(
 			app.AccountKeeper,
 			app.ChainKeeper,
+			app.UpgradeKeeper,
 			app.SupplyKeeper,
 			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
@@ -603,7 +667,7 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		}
 
 		//Hardfork to remove the rotation of validator list on stake update
-		if ctx.BlockHeight() < helper.GetAalborgHardForkHeight() {
+		if !app.UpgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Aalborg) {
 			// increment proposer priority
 			currentValidatorSet.IncrementProposerPriority(1)
 		}
@@ -627,21 +691,8 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		}
 	}
 
-	// Change root chain contract addresses if required
-	if chainManagerAddressMigration, found := helper.GetChainManagerAddressMigration(ctx.BlockHeight()); found {
-		params := app.ChainKeeper.GetParams(ctx)
-
-		params.ChainParams.MaticTokenAddress = chainManagerAddressMigration.MaticTokenAddress
-		params.ChainParams.StakingManagerAddress = chainManagerAddressMigration.StakingManagerAddress
-		params.ChainParams.RootChainAddress = chainManagerAddressMigration.RootChainAddress
-		params.ChainParams.SlashManagerAddress = chainManagerAddressMigration.SlashManagerAddress
-		params.ChainParams.StakingInfoAddress = chainManagerAddressMigration.StakingInfoAddress
-		params.ChainParams.StateSenderAddress = chainManagerAddressMigration.StateSenderAddress
-
-		// update chain manager state
-		app.ChainKeeper.SetParams(ctx, params)
-		logger.Info("Updated chain manager state", "params", params)
-	}
+	// Change root chain contract addresses if a migration is scheduled by governance
+	app.ChainKeeper.ApplyMigration(ctx)
 
 	// end block
 	app.mm.EndBlock(ctx, req)
@@ -652,6 +703,19 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	}
 }
 
+// Close closes the dbs kept by the node outside of the consensus state
+func (app *HeimdallApp) Close() error {
+	if archive := app.CheckpointKeeper.GetMilestoneArchive(); archive != nil {
+		archive.Close()
+	}
+
+	if history := app.CheckpointKeeper.GetCheckpointHistory(); history != nil {
+		history.Close()
+	}
+
+	return nil
+}
+
 // LoadHeight loads a particular height
 func (app *HeimdallApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keys[bam.MainStoreKey])
@@ -0,0 +1,44 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// setUpgradeHandlers sets the handlers of the upgrades known to this binary. The upgrades are
+// activated at the height of a software upgrade proposal, with their handler run once at the
+// height; the nodes without the handler halt there. The handlers are kept once applied, for the
+// nodes syncing the chain.
+func (app *HeimdallApp) setUpgradeHandlers() {
+	// the milestone params are read from the store once active, nothing to migrate
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.MilestoneParams, func(ctx sdk.Context, plan upgradeTypes.Plan) error {
+		return nil
+	})
+
+	// index the existing checkpoints by bor block, the new ones are indexed once active
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.CheckpointIndex, func(ctx sdk.Context, plan upgradeTypes.Plan) error {
+		return app.CheckpointKeeper.IndexCheckpoints(ctx)
+	})
+
+	// the votes are archived when the proposals are tallied once active, the votes on the proposals
+	// tallied before are already deleted
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.VoteArchive, func(ctx sdk.Context, plan upgradeTypes.Plan) error {
+		return nil
+	})
+
+	// the selection params are read from the store once active, the default ones keep the weighted selection
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.ProducerSelection, func(ctx sdk.Context, plan upgradeTypes.Plan) error {
+		return nil
+	})
+
+	// the text and community action proposals are accepted once active, nothing to migrate
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.GovProposalTypes, func(ctx sdk.Context, plan upgradeTypes.Plan) error {
+		return nil
+	})
+
+	// the chain params migrations are scheduled and applied once active, nothing to migrate
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeTypes.ChainParamsMigration, func(ctx sdk.Context, plan upgradeTypes.Plan) error {
+		return nil
+	})
+}
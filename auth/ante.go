@@ -15,6 +15,8 @@ import (
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 var (
@@ -65,6 +67,7 @@ type MainTxMsg interface {
 func NewAnteHandler(
 	ak AccountKeeper,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	feeCollector FeeCollector,
 	contractCaller helper.IContractCaller,
 	sigGasConsumer SignatureVerificationGasConsumer,
@@ -85,7 +88,7 @@ func NewAnteHandler(
 		}
 
 		//Check whether the chain has reached the hard fork length to execute milestone msgs
-		if ctx.BlockHeight() < helper.GetAalborgHardForkHeight() && (stdTx.Msg.Type() == checkpointTypes.EventTypeMilestone || stdTx.Msg.Type() == checkpointTypes.EventTypeMilestoneTimeout) {
+		if !upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Aalborg) && (stdTx.Msg.Type() == checkpointTypes.EventTypeMilestone || stdTx.Msg.Type() == checkpointTypes.EventTypeMilestoneTimeout) {
 			newCtx = SetGasMeter(simulate, ctx, 0)
 			return newCtx, sdk.ErrTxDecode("error decoding transaction").Result(), true
 		}
@@ -174,7 +177,7 @@ func NewAnteHandler(
 		stdSigs := stdTx.GetSignatures()
 
 		// check signature, return account with incremented nonce
-		signBytes := GetSignBytes(ctx, newCtx.ChainID(), stdTx, signerAcc, isGenesis)
+		signBytes := GetSignBytes(ctx, upgradeKeeper, newCtx.ChainID(), stdTx, signerAcc, isGenesis)
 
 		signerAcc, res = processSig(newCtx, signerAcc, stdSigs[0], signBytes, simulate, params, sigGasConsumer)
 		if !res.IsOK() {
@@ -320,7 +323,7 @@ func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64) sdk.Context {
 
 // GetSignBytes returns a slice of bytes to sign over for a given transaction
 // and an account.
-func GetSignBytes(ctx sdk.Context, chainID string, stdTx authTypes.StdTx, acc authTypes.Account, genesis bool) []byte {
+func GetSignBytes(ctx sdk.Context, upgradeKeeper upgrade.Keeper, chainID string, stdTx authTypes.StdTx, acc authTypes.Account, genesis bool) []byte {
 	var accNum uint64
 	if !genesis {
 		accNum = acc.GetAccountNumber()
@@ -328,7 +331,7 @@ func GetSignBytes(ctx sdk.Context, chainID string, stdTx authTypes.StdTx, acc au
 
 	signBytes := authTypes.StdSignBytes(chainID, accNum, acc.GetSequence(), stdTx.Msg, stdTx.Memo)
 
-	if ctx.BlockHeight() > helper.GetNewHexToStringAlgoHeight() {
+	if upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.NewHexToStringAlgo) {
 		return signBytes
 	}
 
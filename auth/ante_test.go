@@ -41,6 +41,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	suite.anteHandler = auth.NewAnteHandler(
 		suite.app.AccountKeeper,
 		suite.app.ChainKeeper,
+		suite.app.UpgradeKeeper,
 		suite.app.SupplyKeeper,
 		&caller,
 		auth.DefaultSigVerificationGasConsumer,
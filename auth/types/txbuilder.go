@@ -29,6 +29,11 @@ type TxBuilder struct {
 	gasPrices          sdk.DecCoins
 }
 
+// Signer signs the keccak256 hash of sign bytes with a key which may not be held in memory
+type Signer interface {
+	Sign(data []byte) ([]byte, error)
+}
+
 // NewTxBuilder returns a new initialized TxBuilder.
 func NewTxBuilder(
 	txEncoder sdk.TxEncoder,
@@ -216,6 +221,16 @@ func (bldr TxBuilder) Sign(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (
 	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
 }
 
+// SignWithSigner signs a transaction with signer given a single message to be signed.
+func (bldr TxBuilder) SignWithSigner(signer Signer, msg StdSignMsg) ([]byte, error) {
+	sig, err := signer.Sign(msg.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+}
+
 // SignWithPassphrase signs a transaction given a name, passphrase, and a single message to
 // signed. An error is returned if signing fails.
 func (bldr TxBuilder) SignWithPassphrase(name, passphrase string, msg StdSignMsg) ([]byte, error) {
@@ -238,6 +253,17 @@ func (bldr TxBuilder) BuildAndSign(privKey secp256k1.PrivKeySecp256k1, msgs []sd
 	return bldr.Sign(privKey, stdMsg)
 }
 
+// BuildAndSignWithSigner builds a single message to be signed, and signs a transaction
+// with the built message with signer given a set of messages.
+func (bldr TxBuilder) BuildAndSignWithSigner(signer Signer, msgs []sdk.Msg) ([]byte, error) {
+	stdMsg, err := bldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.SignWithSigner(signer, stdMsg)
+}
+
 // BuildAndSignWithPassphrase builds a single message to be signed, and signs a transaction
 // with the built message given a name, passphrase, and a set of messages.
 func (bldr TxBuilder) BuildAndSignWithPassphrase(name, passphrase string, msgs []sdk.Msg) ([]byte, error) {
@@ -311,6 +337,30 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 	return
 }
 
+// SignStdTxWithSigner appends a signature made by signer to a StdTx and returns a copy of it.
+func (bldr TxBuilder) SignStdTxWithSigner(signer Signer, stdTx StdTx, appendSig bool) (signedStdTx StdTx, err error) {
+	if bldr.chainID == "" {
+		return StdTx{}, fmt.Errorf("chain ID required but not specified")
+	}
+
+	signMsg := StdSignMsg{
+		ChainID:       bldr.chainID,
+		AccountNumber: bldr.accountNumber,
+		Sequence:      bldr.sequence,
+		Memo:          stdTx.Memo,
+		Msg:           stdTx.Msg, // allow only one message
+	}
+
+	sig, err := signer.Sign(signMsg.Bytes())
+	if err != nil {
+		return
+	}
+
+	signedStdTx = NewStdTx(signMsg.Msg, sig, signMsg.Memo)
+
+	return
+}
+
 // GetStdTxBytes get tx bytes
 func (bldr TxBuilder) GetStdTxBytes(stdTx StdTx) (result []byte, err error) {
 	return bldr.txEncoder(stdTx)
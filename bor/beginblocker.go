@@ -9,6 +9,7 @@ import (
 	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 // ResponseWithHeight defines a response object type that wraps an original
@@ -18,8 +19,9 @@ type ResponseWithHeight struct {
 	Result jsoniter.RawMessage `json:"result"`
 }
 
+// BeginBlocker overrides the spans of the chain in the last block before the span override upgrade
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
-	if ctx.BlockHeight() == helper.GetSpanOverrideHeight() {
+	if height, ok := k.upgradeKeeper.GetUpgradeHeight(ctx, upgradeTypes.SpanOverride); ok && ctx.BlockHeight() == height-1 {
 		k.Logger(ctx).Info("overriding span BeginBlocker", "height", ctx.BlockHeight())
 
 		j, ok := rest.SPAN_OVERRIDES[helper.GenesisDoc.ChainID]
@@ -37,6 +37,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetSpan(cdc),
 			GetLatestSpan(cdc),
 			GetQueryParams(cdc),
+			GetQuerySelectionParams(cdc),
 			GetSpanList(cdc),
 			GetNextSpanSeed(cdc),
 			GetPreparedProposeSpan(cdc),
@@ -156,6 +157,40 @@ $ %s query bor params
 	}
 }
 
+// GetQuerySelectionParams implements the producer selection params query command.
+func GetQuerySelectionParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "selection-params",
+		Args:  cobra.NoArgs,
+		Short: "show the current producer selection parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the producer selector selecting the producers of the next spans, and its parameters.
+
+Example:
+$ %s query bor selection-params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySelectionParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.SelectionParams
+			err = jsoniter.ConfigFastest.Unmarshal(bz, &params)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
 // GetSpan get state record
 func GetSpanList(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -12,15 +12,30 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
 )
 
+// resolver scheme used to fail over between several bor gRPC addresses
+const failoverScheme = "bor-failover"
+
 type BorGRPCClient struct {
 	conn   *grpc.ClientConn
 	client proto.BorApiClient
 }
 
+// NewBorGRPCClient creates a bor gRPC client. With a comma separated list of addresses
+// the client connects to the first reachable one and fails over to the next ones.
 func NewBorGRPCClient(address string) *BorGRPCClient {
-	address = removePrefix(address)
+	addresses := make([]string, 0)
+
+	for _, a := range strings.Split(address, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addresses = append(addresses, removePrefix(a))
+		}
+	}
+
+	address = strings.Join(addresses, ",")
 
 	opts := []grpc_retry.CallOption{
 		grpc_retry.WithMax(5),
@@ -28,11 +43,34 @@ func NewBorGRPCClient(address string) *BorGRPCClient {
 		grpc_retry.WithCodes(codes.Internal, codes.Unavailable, codes.Aborted, codes.NotFound),
 	}
 
-	conn, err := grpc.NewClient(address,
+	dialOpts := []grpc.DialOption{
 		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(opts...)),
 		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(opts...)),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	}
+
+	target := address
+
+	if len(addresses) > 1 {
+		// pick_first tries the addresses in order and moves to the next one when the connection breaks
+		r := manual.NewBuilderWithScheme(failoverScheme)
+
+		state := resolver.State{Addresses: make([]resolver.Address, 0, len(addresses))}
+		for _, a := range addresses {
+			state.Addresses = append(state.Addresses, resolver.Address{Addr: a})
+		}
+
+		r.InitialState(state)
+
+		dialOpts = append(dialOpts,
+			grpc.WithResolvers(r),
+			grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"pick_first":{}}]}`),
+		)
+
+		target = failoverScheme + ":///bor"
+	}
+
+	conn, err := grpc.NewClient(target, dialOpts...)
 	if err != nil {
 		log.Crit("Failed to connect to Bor gRPC", "error", err)
 	}
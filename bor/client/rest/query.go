@@ -115,6 +115,25 @@ type spanParams struct {
 	ProducerCount int64 `json:"producer_count"`
 }
 
+// It represents the producer selection parameters
+//
+//swagger:response borSelectionParamsResponse
+type borSelectionParamsResponse struct {
+	//in:body
+	Output borSelectionParams `json:"output"`
+}
+
+type borSelectionParams struct {
+	Height string          `json:"height"`
+	Result selectionParams `json:"result"`
+}
+
+type selectionParams struct {
+	ProducerSelector string `json:"producer_selector"`
+	//type:integer
+	ProducerCap int64 `json:"producer_cap"`
+}
+
 // It represents the next span seed
 //
 //swagger:response borNextSpanSeedResponse
@@ -137,6 +156,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bor/prepare-next-span", prepareNextSpanHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/next-span-seed/{id}", fetchNextSpanSeedHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bor/selection-params", selectionParamsHandlerFn(cliCtx)).Methods("GET")
 }
 
 //swagger:parameters borCurrentSpanById
@@ -554,6 +574,31 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// swagger:route GET /bor/selection-params bor borSelectionParams
+// It returns the producer selection parameters
+// responses:
+//
+//	200: borSelectionParamsResponse
+func selectionParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySelectionParams)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ResponseWithHeight defines a response object type that wraps an original
 // response with a height.
 // TODO:Link it with bor
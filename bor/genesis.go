@@ -11,6 +11,10 @@ import (
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
+	if data.SelectionParams != nil {
+		keeper.SetSelectionParams(ctx, *data.SelectionParams)
+	}
+
 	if len(data.Spans) > 0 {
 		// sort data spans before inserting to ensure lastspanId fetched is correct
 		hmTypes.SortSpanByID(data.Spans)
@@ -29,12 +33,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
+	selectionParams := keeper.GetSelectionParams(ctx)
 
 	allSpans := keeper.GetAllSpans(ctx)
 	hmTypes.SortSpanByID(allSpans)
 
 	return types.NewGenesisState(
 		params,
+		&selectionParams,
 		// TODO think better way to export all spans
 		allSpans,
 	)
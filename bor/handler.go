@@ -8,7 +8,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 // NewHandler returns a handler for "bor" type messages.
@@ -31,7 +31,7 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 	var proposeMsg types.MsgProposeSpanV2
 	switch msg := msg.(type) {
 	case types.MsgProposeSpan:
-		if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			err := errors.New("msg span is not allowed after Danelaw hardfork height")
 			k.Logger(ctx).Error(err.Error())
 			return sdk.ErrTxDecode(err.Error()).Result()
@@ -45,7 +45,7 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 			Seed:       msg.Seed,
 		}
 	case types.MsgProposeSpanV2:
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			err := errors.New("msg span v2 is not allowed before Danelaw hardfork height")
 			k.Logger(ctx).Error(err.Error())
 			return sdk.ErrTxDecode(err.Error()).Result()
@@ -20,6 +20,8 @@ import (
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 const maxSpanListLimit = 150 // a span is ~6 KB => we can fit 150 spans in 1 MB response
@@ -47,6 +49,8 @@ type Keeper struct {
 	contractCaller helper.IContractCaller
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
 }
 
 // NewKeeper is the constructor of Keeper
@@ -57,6 +61,7 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
 	stakingKeeper staking.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	caller *helper.ContractCaller,
 ) Keeper {
 	return Keeper{
@@ -66,6 +71,7 @@ func NewKeeper(
 		codespace:      codespace,
 		chainKeeper:    chainKeeper,
 		sk:             stakingKeeper,
+		upgradeKeeper:  upgradeKeeper,
 		contractCaller: caller,
 	}
 }
@@ -215,7 +221,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 		err          error
 	)
 
-	if ctx.BlockHeight() < helper.GetJorvikHeight() {
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Jorvik) {
 		newProducers, err = k.SelectNextProducers(ctx, seed, nil)
 		if err != nil {
 			return err
@@ -266,7 +272,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 
 // SelectNextProducers selects producers for next span
 func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash, prevVals []hmTypes.Validator) (vals []hmTypes.Validator, err error) {
-	if ctx.BlockHeight() < helper.GetJorvikHeight() {
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Jorvik) {
 		prevVals = nil
 	}
 
@@ -288,14 +294,8 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash, prevVals
 		spanEligibleVals = rollbackVotingPowers(ctx, spanEligibleVals, prevVals)
 	}
 
-	// TODO remove old selection algorithm
 	// select next producers using seed as block header hash
-	fn := SelectNextProducers
-	if ctx.BlockHeight() < helper.GetNewSelectionAlgoHeight() {
-		fn = XXXSelectNextProducers
-	}
-
-	newProducersIds, err := fn(seed, spanEligibleVals, producerCount)
+	newProducersIds, err := k.GetProducerSelector(ctx).SelectProducers(seed, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
 	}
@@ -366,7 +366,7 @@ func (k *Keeper) GetNextSpanSeed(ctx sdk.Context, id uint64) (common.Hash, commo
 		author      *common.Address
 	)
 
-	if ctx.BlockHeight() < helper.GetJorvikHeight() {
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Jorvik) {
 		lastEthBlock := k.GetLastEthBlock(ctx)
 		// increment last processed header block number
 		newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
@@ -461,6 +461,59 @@ func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// SetSelectionParams sets the producer selection parameters
+func (k *Keeper) SetSelectionParams(ctx sdk.Context, params types.SelectionParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetSelectionParams gets the producer selection parameters in effect at the current height.
+// Before the producer selection upgrade, and for the params missing from the store or invalid,
+// the default ones are used
+func (k *Keeper) GetSelectionParams(ctx sdk.Context) types.SelectionParams {
+	params := types.DefaultSelectionParams()
+
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.ProducerSelection) {
+		return params
+	}
+
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	// param change proposals leaving invalid selection params are rejected, this only
+	// guards against the values stored before
+	valid, replaced := params.WithDefaults()
+	if len(replaced) > 0 {
+		k.Logger(ctx).Error("Invalid selection params in store, using the default ones", "params", params.String(), "replaced", replaced)
+	}
+
+	return valid
+}
+
+// GetProducerSelector returns the producer selector in effect at the current height. Before the
+// producer selection upgrade, the selector is switched by the new selection algo upgrade
+func (k *Keeper) GetProducerSelector(ctx sdk.Context) types.ProducerSelector {
+	params := k.GetSelectionParams(ctx)
+
+	name := params.ProducerSelector
+	if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.ProducerSelection) && !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.NewSelectionAlgo) {
+		name = types.ProducerSelectorShuffle
+	}
+
+	selector, ok := types.GetProducerSelector(name, params)
+	if !ok {
+		// the params are validated on read, a selector missing from this binary falls back to the default one
+		k.Logger(ctx).Error("Producer selector not registered, using the default one", "name", name, "default", types.DefaultProducerSelector)
+
+		params = types.DefaultSelectionParams()
+		if selector, ok = types.GetProducerSelector(params.ProducerSelector, params); !ok {
+			panic(fmt.Sprintf("default producer selector %s not registered", params.ProducerSelector))
+		}
+	}
+
+	return selector
+}
+
 //
 // Utils
 //
@@ -9,7 +9,10 @@ import (
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bor"
+	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/params"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -257,3 +260,52 @@ func setupValSet() *hmTypes.ValidatorSet {
 
 	return valSet
 }
+
+func (s *BorKeeperTestSuite) TestGetProducerSelectorUnknown() {
+	require, ctx, borKeeper := s.Require(), s.ctx, s.app.BorKeeper
+
+	borKeeper.SetSelectionParams(ctx, types.NewSelectionParams("unknown", 1))
+	require.Equal(types.DefaultSelectionParams(), borKeeper.GetSelectionParams(ctx))
+
+	// only the invalid params in store are replaced by their default value
+	borKeeper.SetSelectionParams(ctx, types.NewSelectionParams(types.ProducerSelectorCapped, 0))
+	require.Equal(types.NewSelectionParams(types.ProducerSelectorCapped, types.DefaultProducerCap), borKeeper.GetSelectionParams(ctx))
+
+	borKeeper.SetSelectionParams(ctx, types.NewSelectionParams("unknown", 1))
+
+	selector := borKeeper.GetProducerSelector(ctx)
+	require.NotNil(selector)
+
+	valSet := s.setupValSet()
+	vals := make([]hmTypes.Validator, 0, len(valSet.Validators))
+	for _, val := range valSet.Validators {
+		vals = append(vals, *val)
+	}
+
+	producerIds, err := selector.SelectProducers(common.HexToHash("0x1"), vals, 2)
+	require.NoError(err)
+	require.Len(producerIds, 2)
+}
+
+func (s *BorKeeperTestSuite) TestSelectionParamsProposal() {
+	require, ctx, app := s.Require(), s.ctx, s.app
+
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	// a param change proposal leaving invalid selection params is rejected
+	for _, change := range []paramsTypes.ParamChange{
+		paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyProducerSelector), `"unknown"`),
+		paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyProducerCap), `"0"`),
+	} {
+		cacheCtx, _ := ctx.CacheContext()
+		proposal := paramsTypes.NewParameterChangeProposal("Selection params", "description", []paramsTypes.ParamChange{change})
+		require.Error(handler(cacheCtx, proposal))
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	proposal := paramsTypes.NewParameterChangeProposal("Selection params", "description", []paramsTypes.ParamChange{
+		paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyProducerSelector), `"capped"`),
+		paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyProducerCap), `"2"`),
+	})
+	require.NoError(handler(cacheCtx, proposal))
+}
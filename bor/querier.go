@@ -31,12 +31,23 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryNextProducers(ctx, req, keeper)
 		case types.QueryNextSpanSeed:
 			return handlerQueryNextSpanSeed(ctx, req, keeper)
+		case types.QuerySelectionParams:
+			return handleQuerySelectionParams(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
 	}
 }
 
+func handleQuerySelectionParams(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetSelectionParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	if len(path) == 0 {
 		bz, err := jsoniter.ConfigFastest.Marshal(keeper.GetParams(ctx))
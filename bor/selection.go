@@ -13,6 +13,22 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+func init() {
+	types.RegisterProducerSelector(types.ProducerSelectorShuffle, func(types.SelectionParams) types.ProducerSelector {
+		return types.ProducerSelectorFunc(XXXSelectNextProducers)
+	})
+
+	types.RegisterProducerSelector(types.ProducerSelectorWeighted, func(types.SelectionParams) types.ProducerSelector {
+		return types.ProducerSelectorFunc(SelectNextProducers)
+	})
+
+	types.RegisterProducerSelector(types.ProducerSelectorCapped, func(params types.SelectionParams) types.ProducerSelector {
+		return types.ProducerSelectorFunc(func(seed common.Hash, validators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+			return SelectCappedProducers(seed, validators, producerCount, params.ProducerCap)
+		})
+	})
+}
+
 // XXXSelectNextProducers selects producers for next span by converting power to tickets
 func XXXSelectNextProducers(blkHash common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) (selectedIDs []uint64, err error) {
 	if producerCount > math.MaxInt64 {
@@ -103,6 +119,72 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 	return selectedProducers[:producerCount], nil
 }
 
+//
+// Capped selection algorithm
+//
+
+// SelectCappedProducers selects producers for next span weighted by voting power, without replacement
+// once a validator is selected producerCap times. It keeps a validator with most of the voting power
+// from taking most of the producer slots. When the validators with voting power have less than
+// producerCount slots under the cap, each of them is selected producerCap times and fewer producers
+// than producerCount are returned, as with fewer eligible validators than producerCount
+func SelectCappedProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64, producerCap uint64) ([]uint64, error) {
+	selectedProducers := make([]uint64, 0)
+
+	if producerCount > math.MaxInt64 {
+		return nil, fmt.Errorf("producer count value out of range for int: %d", producerCount)
+	}
+	if producerCap == 0 {
+		return nil, fmt.Errorf("invalid producer cap: %d", producerCap)
+	}
+	if len(spanEligibleValidators) <= int(producerCount) {
+		for _, validator := range spanEligibleValidators {
+			selectedProducers = append(selectedProducers, uint64(validator.ID))
+		}
+
+		return selectedProducers, nil
+	}
+
+	// extract seed from hash, the draws use their own source instead of the global one
+	seedBytes := helper.ToBytes32(blkHash.Bytes()[:32])
+	//nolint: gosec
+	seed := int64(binary.BigEndian.Uint64(seedBytes[:]))
+	//nolint: gosec
+	source := rand.New(rand.NewSource(seed))
+
+	votingPower := make([]uint64, len(spanEligibleValidators))
+	for idx, validator := range spanEligibleValidators {
+		if validator.VotingPower < 0 {
+			return nil, fmt.Errorf("voting power value is negative: %d", validator.VotingPower)
+		}
+		votingPower[idx] = uint64(validator.VotingPower)
+	}
+
+	// select producers, dropping the validators from the draw once they reach the cap
+	slots := make([]uint64, len(spanEligibleValidators))
+	for uint64(len(selectedProducers)) < producerCount {
+		weightedRanges, totalVotingPower := createWeightedRanges(votingPower)
+		if totalVotingPower == 0 {
+			break
+		}
+
+		targetWeight := randomRangeInclusiveFrom(source.Uint64, 1, totalVotingPower)
+		index := binarySearch(weightedRanges, targetWeight)
+		selectedProducers = append(selectedProducers, spanEligibleValidators[index].ID.Uint64())
+
+		slots[index]++
+		if slots[index] >= producerCap {
+			votingPower[index] = 0
+		}
+	}
+
+	if len(selectedProducers) == 0 {
+		return nil, fmt.Errorf("no voting power to select producers from")
+	}
+
+	return selectedProducers, nil
+}
+
 func binarySearch(array []uint64, search uint64) int {
 	if len(array) == 0 {
 		return -1
@@ -125,17 +207,22 @@ func binarySearch(array []uint64, search uint64) int {
 
 // randomRangeInclusive produces unbiased pseudo random in the range [min, max]. Uses rand.Uint64() and can be seeded beforehand.
 func randomRangeInclusive(minV uint64, maxV uint64) uint64 {
+	return randomRangeInclusiveFrom(rand.Uint64, minV, maxV) //nolint
+}
+
+// randomRangeInclusiveFrom produces unbiased pseudo random in the range [min, max] from the random source.
+func randomRangeInclusiveFrom(source func() uint64, minV uint64, maxV uint64) uint64 {
 	if maxV <= minV {
 		return maxV
 	}
 
 	rangeLength := maxV - minV + 1
 	maxAllowedValue := math.MaxUint64 - math.MaxUint64%rangeLength - 1
-	randomValue := rand.Uint64() //nolint
+	randomValue := source()
 
 	// reject anything that is beyond the reminder to avoid bias
 	for randomValue >= maxAllowedValue {
-		randomValue = rand.Uint64() //nolint
+		randomValue = source()
 	}
 
 	return minV + randomValue%rangeLength
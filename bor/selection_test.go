@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -101,6 +102,92 @@ func TestSelectNextProducers(t *testing.T) {
 	}
 }
 
+func TestSelectCappedProducers(t *testing.T) {
+	t.Parallel()
+
+	var validators []hmTypes.Validator
+	err := jsoniter.ConfigFastest.Unmarshal([]byte(testValidators), &validators)
+	require.NoError(t, err)
+
+	// give most of the voting power to a validator
+	validators[0].VotingPower = 1000000
+
+	seed := common.HexToHash("0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a")
+
+	for _, producerCap := range []uint64{1, 2, 3} {
+		producerIds, err := SelectCappedProducers(seed, validators, 4, producerCap)
+		require.NoError(t, err)
+		require.Len(t, producerIds, 4)
+
+		// the selection is deterministic for a seed
+		again, err := SelectCappedProducers(seed, validators, 4, producerCap)
+		require.NoError(t, err)
+		require.Equal(t, producerIds, again)
+
+		producers, slots := getSelectedValidatorsFromIDs(validators, producerIds)
+		require.Equal(t, int64(4), slots)
+
+		for _, producer := range producers {
+			require.LessOrEqual(t, uint64(producer.VotingPower), producerCap, "producer %d over the cap %d", producer.ID, producerCap)
+		}
+	}
+
+	// without replacement, a cap of 1 selects distinct producers
+	producerIds, err := SelectCappedProducers(seed, validators, 5, 1)
+	require.NoError(t, err)
+	require.Len(t, producerIds, 5)
+
+	producers, _ := getSelectedValidatorsFromIDs(validators, producerIds)
+	require.Len(t, producers, 5)
+
+	// with less than producerCount slots under the cap, every validator with voting power
+	// is selected producerCap times, and fewer producers than asked are returned
+	capped := append([]hmTypes.Validator{}, validators...)
+	for i := 2; i < len(capped); i++ {
+		capped[i].VotingPower = 0
+	}
+
+	producerIds, err = SelectCappedProducers(seed, capped, 4, 1)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{capped[0].ID.Uint64(), capped[1].ID.Uint64()}, producerIds)
+
+	_, err = SelectCappedProducers(seed, validators, 4, 0)
+	require.Error(t, err)
+}
+
+func TestProducerSelectors(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{types.ProducerSelectorCapped, types.ProducerSelectorShuffle, types.ProducerSelectorWeighted}, types.ProducerSelectorNames())
+	require.Panics(t, func() {
+		types.RegisterProducerSelector(types.ProducerSelectorWeighted, func(types.SelectionParams) types.ProducerSelector { return nil })
+	})
+
+	_, ok := types.GetProducerSelector("unknown", types.DefaultSelectionParams())
+	require.False(t, ok)
+
+	require.NoError(t, types.DefaultSelectionParams().Validate())
+	require.Error(t, types.NewSelectionParams("unknown", 1).Validate())
+	require.Error(t, types.NewSelectionParams(types.ProducerSelectorCapped, 0).Validate())
+
+	var validators []hmTypes.Validator
+	err := jsoniter.ConfigFastest.Unmarshal([]byte(testValidators), &validators)
+	require.NoError(t, err)
+
+	seed := common.HexToHash("0xe09cc356df20c7a2dd38cb85b680a16ec29bd8b3e1ecc1b20f2e5603d5e7ee85")
+
+	// the registered selectors select the same producers as the selection functions
+	selector, ok := types.GetProducerSelector(types.ProducerSelectorCapped, types.NewSelectionParams(types.ProducerSelectorCapped, 2))
+	require.True(t, ok)
+
+	producerIds, err := selector.SelectProducers(seed, validators, 4)
+	require.NoError(t, err)
+
+	expected, err := SelectCappedProducers(seed, validators, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, producerIds)
+}
+
 func getSelectedValidatorsFromIDs(validators []hmTypes.Validator, producerIds []uint64) ([]hmTypes.Validator, int64) {
 	var vals []hmTypes.Validator
 
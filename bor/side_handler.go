@@ -13,6 +13,7 @@ import (
 	"github.com/maticnetwork/heimdall/helper"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 )
@@ -53,7 +54,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 	var proposeMsg types.MsgProposeSpanV2
 	switch msg := msg.(type) {
 	case types.MsgProposeSpan:
-		if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			k.Logger(ctx).Error("Msg span is not allowed after Danelaw hardfork height")
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 		}
@@ -66,7 +67,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 			Seed:       msg.Seed,
 		}
 	case types.MsgProposeSpanV2:
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			k.Logger(ctx).Error("Msg span v2 is not allowed before Danelaw hardfork height")
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 		}
@@ -97,7 +98,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, contractCaller he
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
-	if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+	if k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 		// check if span seed author matches or not.
 		if !bytes.Equal(proposeMsg.SeedAuthor.Bytes(), seedAuthor.Bytes()) {
 			k.Logger(ctx).Error(
@@ -161,7 +162,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 	var proposeMsg types.MsgProposeSpanV2
 	switch msg := msg.(type) {
 	case types.MsgProposeSpan:
-		if ctx.BlockHeight() >= helper.GetDanelawHeight() {
+		if k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			k.Logger(ctx).Error("Msg span is not allowed after Danelaw hardfork height")
 			return common.ErrSideTxValidation(k.Codespace()).Result()
 		}
@@ -174,7 +175,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 			Seed:       msg.Seed,
 		}
 	case types.MsgProposeSpanV2:
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			k.Logger(ctx).Error("Msg span v2 is not allowed before Danelaw hardfork height")
 			return common.ErrSideTxValidation(k.Codespace()).Result()
 		}
@@ -196,7 +197,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 		"seed", proposeMsg.Seed.String(),
 	)
 
-	if ctx.BlockHeight() >= helper.GetJorvikHeight() {
+	if k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Jorvik) {
 		var seedSpanID uint64
 		if proposeMsg.ID < 2 {
 			seedSpanID = proposeMsg.ID - 1
@@ -212,7 +213,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg sdk.Msg, sideTxResult
 
 		var producer *ethCommon.Address
 
-		if ctx.BlockHeight() < helper.GetDanelawHeight() {
+		if !k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Danelaw) {
 			// store the seed producer
 			_, producer, err = k.getBorBlockForSpanSeed(ctx, lastSpan, proposeMsg.ID)
 			if err != nil {
@@ -0,0 +1,124 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SelectionStats sums up the producers selected by a producer selector over many spans
+type SelectionStats struct {
+	Spans int
+	// producer slots of each validator over all the spans
+	Slots map[uint64]uint64
+	// largest number of slots of a validator in a span
+	MaxSpanSlots uint64
+	// mean number of distinct producers per span
+	MeanDistinctProducers float64
+	// largest gap between the share of the slots and the share of the voting power of a validator
+	MaxShareGap float64
+}
+
+// SlotShare returns the share of the producer slots of a validator over all the spans
+func (s SelectionStats) SlotShare(id uint64) float64 {
+	var total uint64
+	for _, slots := range s.Slots {
+		total += slots
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Slots[id]) / float64(total)
+}
+
+// String implements the stringer interface.
+func (s SelectionStats) String() string {
+	ids := make([]uint64, 0, len(s.Slots))
+	for id := range s.Slots {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Spans: %d\n", s.Spans))
+	sb.WriteString(fmt.Sprintf("MaxSpanSlots: %d\n", s.MaxSpanSlots))
+	sb.WriteString(fmt.Sprintf("MeanDistinctProducers: %.2f\n", s.MeanDistinctProducers))
+	sb.WriteString(fmt.Sprintf("MaxShareGap: %.4f\n", s.MaxShareGap))
+
+	for _, id := range ids {
+		sb.WriteString(fmt.Sprintf("  %d: %d slots (%.4f)\n", id, s.Slots[id], s.SlotShare(id)))
+	}
+
+	return sb.String()
+}
+
+// SimulateProducerSelection runs a producer selector over spans, seeding each span with the hash of
+// its number, and sums up the selected producers
+func SimulateProducerSelection(selector types.ProducerSelector, validators []hmTypes.Validator, producerCount uint64, spans int) (SelectionStats, error) {
+	stats := SelectionStats{
+		Spans: spans,
+		Slots: make(map[uint64]uint64),
+	}
+
+	var totalPower int64
+	for _, validator := range validators {
+		totalPower += validator.VotingPower
+	}
+
+	if totalPower <= 0 {
+		return stats, fmt.Errorf("no voting power to select producers from")
+	}
+
+	var distinctProducers int
+
+	for span := 0; span < spans; span++ {
+		spanNumber := make([]byte, 8)
+		binary.BigEndian.PutUint64(spanNumber, uint64(span))
+
+		producers, err := selector.SelectProducers(crypto.Keccak256Hash(spanNumber), validators, producerCount)
+		if err != nil {
+			return stats, err
+		}
+
+		spanSlots := make(map[uint64]uint64)
+		for _, id := range producers {
+			spanSlots[id]++
+			stats.Slots[id]++
+		}
+
+		for _, slots := range spanSlots {
+			if slots > stats.MaxSpanSlots {
+				stats.MaxSpanSlots = slots
+			}
+		}
+
+		distinctProducers += len(spanSlots)
+	}
+
+	if spans > 0 {
+		stats.MeanDistinctProducers = float64(distinctProducers) / float64(spans)
+	}
+
+	for _, validator := range validators {
+		gap := stats.SlotShare(validator.ID.Uint64()) - float64(validator.VotingPower)/float64(totalPower)
+		if gap < 0 {
+			gap = -gap
+		}
+
+		if gap > stats.MaxShareGap {
+			stats.MaxShareGap = gap
+		}
+	}
+
+	return stats, nil
+}
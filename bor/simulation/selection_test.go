@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// registers the producer selectors
+	_ "github.com/maticnetwork/heimdall/bor"
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// TestProducerSelectionFairness compares the producer selectors on a validator set with a validator
+// holding 40% of the voting power
+func TestProducerSelectionFairness(t *testing.T) {
+	validators := []hmTypes.Validator{{ID: 1, VotingPower: 400}}
+	for id := uint64(2); id <= 21; id++ {
+		validators = append(validators, hmTypes.Validator{ID: hmTypes.NewValidatorID(id), VotingPower: 30})
+	}
+
+	const (
+		producerCount uint64 = 4
+		spans                = 2000
+	)
+
+	stats := make(map[string]SelectionStats)
+
+	for _, name := range []string{types.ProducerSelectorShuffle, types.ProducerSelectorWeighted, types.ProducerSelectorCapped} {
+		selector, ok := types.GetProducerSelector(name, types.NewSelectionParams(name, 1))
+		require.True(t, ok)
+
+		s, err := SimulateProducerSelection(selector, validators, producerCount, spans)
+		require.NoError(t, err)
+
+		t.Logf("%s selector\n%s", name, s)
+
+		stats[name] = s
+	}
+
+	weighted, capped := stats[types.ProducerSelectorWeighted], stats[types.ProducerSelectorCapped]
+
+	// the weighted selector follows the voting power, the largest validator taking several slots of a span
+	require.Less(t, weighted.MaxShareGap, 0.05)
+	require.Greater(t, weighted.MaxSpanSlots, uint64(1))
+
+	// the capped selector spreads the slots of the largest validator over the other ones
+	require.Equal(t, uint64(1), capped.MaxSpanSlots)
+	require.Equal(t, float64(producerCount), capped.MeanDistinctProducers)
+	require.Less(t, capped.SlotShare(1), weighted.SlotShare(1))
+	require.Greater(t, capped.MeanDistinctProducers, weighted.MeanDistinctProducers)
+}
+
+func TestSimulateProducerSelectionDeterministic(t *testing.T) {
+	validators := []hmTypes.Validator{
+		{ID: 1, VotingPower: 100},
+		{ID: 2, VotingPower: 50},
+		{ID: 3, VotingPower: 20},
+		{ID: 4, VotingPower: 10},
+		{ID: 5, VotingPower: 10},
+	}
+
+	selector, ok := types.GetProducerSelector(types.ProducerSelectorCapped, types.NewSelectionParams(types.ProducerSelectorCapped, 2))
+	require.True(t, ok)
+
+	s1, err := SimulateProducerSelection(selector, validators, 3, 100)
+	require.NoError(t, err)
+
+	s2, err := SimulateProducerSelection(selector, validators, 3, 100)
+	require.NoError(t, err)
+
+	require.Equal(t, s1, s2)
+	require.LessOrEqual(t, s1.MaxSpanSlots, uint64(2))
+
+	_, err = SimulateProducerSelection(selector, []hmTypes.Validator{{ID: 1}}, 3, 1)
+	require.Error(t, err)
+}
@@ -10,21 +10,25 @@ import (
 
 // GenesisState is the bor state that must be provided at genesis.
 type GenesisState struct {
-	Params Params          `json:"params" yaml:"params"`
-	Spans  []*hmTypes.Span `json:"spans" yaml:"spans"` // list of spans
+	Params Params `json:"params" yaml:"params"`
+	// nil in genesis files created before the selection params, the default ones are used then
+	SelectionParams *SelectionParams `json:"selection_params,omitempty" yaml:"selection_params,omitempty"`
+	Spans           []*hmTypes.Span  `json:"spans" yaml:"spans"` // list of spans
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, spans []*hmTypes.Span) GenesisState {
+func NewGenesisState(params Params, selectionParams *SelectionParams, spans []*hmTypes.Span) GenesisState {
 	return GenesisState{
-		Params: params,
-		Spans:  spans,
+		Params:          params,
+		SelectionParams: selectionParams,
+		Spans:           spans,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), nil)
+	selectionParams := DefaultSelectionParams()
+	return NewGenesisState(DefaultParams(), &selectionParams, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
@@ -34,6 +38,12 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if data.SelectionParams != nil {
+		if err := data.SelectionParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
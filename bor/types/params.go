@@ -100,7 +100,7 @@ func (p Params) Validate() error {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterParamSet(&SelectionParams{})
 }
 
 // DefaultParams returns a default set of parameters.
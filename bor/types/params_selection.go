@@ -0,0 +1,107 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default producer selection parameter values, the weighted selection used before the params activation
+const (
+	DefaultProducerSelector        = ProducerSelectorWeighted
+	DefaultProducerCap      uint64 = 1
+)
+
+// Producer selection parameter keys
+var (
+	KeyProducerSelector = []byte("ProducerSelector")
+	KeyProducerCap      = []byte("ProducerCap")
+)
+
+var _ subspace.ParamSet = &SelectionParams{}
+
+// SelectionParams defines the producer selection parameters, stored in the bor subspace
+type SelectionParams struct {
+	// name of the registered producer selector selecting the producers of the next spans
+	ProducerSelector string `json:"producer_selector" yaml:"producer_selector"`
+	// max number of producer slots of a validator in a span, for the capped selector
+	ProducerCap uint64 `json:"producer_cap" yaml:"producer_cap"`
+}
+
+// NewSelectionParams creates a new SelectionParams object
+func NewSelectionParams(producerSelector string, producerCap uint64) SelectionParams {
+	return SelectionParams{
+		ProducerSelector: producerSelector,
+		ProducerCap:      producerCap,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of the producer selection parameters.
+// nolint
+func (p *SelectionParams) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyProducerSelector, &p.ProducerSelector},
+		{KeyProducerCap, &p.ProducerCap},
+	}
+}
+
+// Equal returns a boolean determining if two SelectionParams types are identical.
+func (p SelectionParams) Equal(p2 SelectionParams) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultSelectionParams returns a default set of producer selection parameters.
+func DefaultSelectionParams() SelectionParams {
+	return SelectionParams{
+		ProducerSelector: DefaultProducerSelector,
+		ProducerCap:      DefaultProducerCap,
+	}
+}
+
+// String implements the stringer interface.
+func (p SelectionParams) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("SelectionParams: \n")
+	sb.WriteString(fmt.Sprintf("ProducerSelector: %s\n", p.ProducerSelector))
+	sb.WriteString(fmt.Sprintf("ProducerCap: %d\n", p.ProducerCap))
+
+	return sb.String()
+}
+
+// Validate checks that the producer selection parameters have valid values.
+func (p SelectionParams) Validate() error {
+	if !IsProducerSelectorRegistered(p.ProducerSelector) {
+		return fmt.Errorf("unknown producer selector %q, registered: %s", p.ProducerSelector, strings.Join(ProducerSelectorNames(), ", "))
+	}
+
+	if p.ProducerCap == 0 {
+		return fmt.Errorf("ProducerCap should be greater than zero")
+	}
+
+	return nil
+}
+
+// WithDefaults returns the producer selection parameters with each invalid parameter replaced
+// by its default value, and the keys of the replaced parameters
+func (p SelectionParams) WithDefaults() (SelectionParams, []string) {
+	var replaced []string
+
+	if !IsProducerSelectorRegistered(p.ProducerSelector) {
+		p.ProducerSelector = DefaultProducerSelector
+		replaced = append(replaced, string(KeyProducerSelector))
+	}
+
+	if p.ProducerCap == 0 {
+		p.ProducerCap = DefaultProducerCap
+		replaced = append(replaced, string(KeyProducerCap))
+	}
+
+	return p, replaced
+}
@@ -12,6 +12,8 @@ const (
 	QueryNextProducers = "next-producers"
 	QueryNextSpanSeed  = "next-span-seed"
 
+	QuerySelectionParams = "selection-params"
+
 	ParamSpan          = "span"
 	ParamSprint        = "sprint"
 	ParamProducerCount = "producer-count"
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Names of the producer selectors of the bor module
+const (
+	// ProducerSelectorShuffle shuffles the validator slots, one slot per SlotCost of voting power
+	ProducerSelectorShuffle = "shuffle"
+	// ProducerSelectorWeighted draws the producers weighted by voting power, with replacement
+	ProducerSelectorWeighted = "weighted"
+	// ProducerSelectorCapped draws the producers weighted by voting power, a validator being
+	// dropped from the draw once selected ProducerCap times
+	ProducerSelectorCapped = "capped"
+)
+
+// ProducerSelector selects the producers of a span among the span eligible validators. The selection
+// must be deterministic for a seed. The ids of the selected validators are returned, a validator
+// selected n times gets n producer slots in the span
+type ProducerSelector interface {
+	SelectProducers(seed common.Hash, validators []hmTypes.Validator, producerCount uint64) ([]uint64, error)
+}
+
+// ProducerSelectorFunc implements ProducerSelector with a function
+type ProducerSelectorFunc func(seed common.Hash, validators []hmTypes.Validator, producerCount uint64) ([]uint64, error)
+
+// SelectProducers calls the function
+func (f ProducerSelectorFunc) SelectProducers(seed common.Hash, validators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	return f(seed, validators, producerCount)
+}
+
+// NewProducerSelector returns the producer selector of a strategy for the selection params
+type NewProducerSelector func(params SelectionParams) ProducerSelector
+
+var producerSelectors = map[string]NewProducerSelector{}
+
+// RegisterProducerSelector registers a producer selection strategy by name. The strategies must be
+// registered before the first block, by all the nodes of the chain
+func RegisterProducerSelector(name string, newSelector NewProducerSelector) {
+	if name == "" {
+		panic("producer selector name cannot be empty")
+	}
+
+	if _, ok := producerSelectors[name]; ok {
+		panic(fmt.Sprintf("producer selector %s already registered", name))
+	}
+
+	producerSelectors[name] = newSelector
+}
+
+// GetProducerSelector returns the producer selector registered by name for the selection params
+func GetProducerSelector(name string, params SelectionParams) (ProducerSelector, bool) {
+	newSelector, ok := producerSelectors[name]
+	if !ok {
+		return nil, false
+	}
+
+	return newSelector(params), true
+}
+
+// IsProducerSelectorRegistered returns true if a producer selector is registered by name
+func IsProducerSelectorRegistered(name string) bool {
+	_, ok := producerSelectors[name]
+	return ok
+}
+
+// ProducerSelectorNames returns the sorted names of the registered producer selectors
+func ProducerSelectorNames() []string {
+	names := make([]string, 0, len(producerSelectors))
+	for name := range producerSelectors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
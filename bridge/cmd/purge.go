@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
 )
 
@@ -13,7 +15,11 @@ var purgeCmd = &cobra.Command{
 	Short: "Reset bridge queue tasks",
 	Run: func(cmd *cobra.Command, args []string) {
 		// purge Queue
-		purgeQueue()
+		if helper.GetConfig().TaskQueueBackend == queue.EmbeddedBackend {
+			purgeEmbeddedQueue()
+		} else {
+			purgeQueue()
+		}
 	},
 }
 
@@ -37,6 +43,18 @@ func purgeQueue() {
 	}
 }
 
+func purgeEmbeddedQueue() {
+	defer util.CloseBridgeDBInstance()
+
+	count, err := queue.PurgeEmbeddedQueue(util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag)))
+	if err != nil {
+		logger.Error("purgeEmbeddedQueue | PurgeEmbeddedQueue", "Error", err)
+		return
+	}
+
+	logger.Info("Purged embedded queue", "tasks", count)
+}
+
 func init() {
 	rootCmd.AddCommand(purgeCmd)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
 	"github.com/maticnetwork/heimdall/bridge/setu/processor"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/common"
@@ -35,8 +36,13 @@ const (
 func StartBridgeWithCtx(shutdownCtx context.Context) error {
 	// create codec
 	cdc := app.MakeCodec()
-	// queue connector & http client
-	_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	// task queue & http client
+	_queueConnector, err := queue.NewTaskQueue(helper.GetConfig())
+	if err != nil {
+		logger.Error("Error creating task queue", "backend", helper.GetConfig().TaskQueueBackend, "error", err)
+		return err
+	}
+
 	_queueConnector.StartWorker()
 
 	_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
@@ -47,10 +53,12 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 	services = append(services,
 		listener.NewListenerService(cdc, _queueConnector, _httpClient),
 		processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+		_txBroadcaster.RootchainTxManager(),
+		status.NewServer(cdc, _queueConnector, _httpClient, _txBroadcaster.RootchainTxManager()),
 	)
 
 	// Start http client
-	err := _httpClient.Start()
+	err = _httpClient.Start()
 	if err != nil {
 		logger.Error("Error connecting to server: %v", err)
 		return err
@@ -115,6 +123,8 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 			logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
 			return err
 		}
+		// stop task queue
+		_queueConnector.Stop()
 		// stop db instance
 		util.CloseBridgeDBInstance()
 
@@ -134,8 +144,12 @@ func StartBridgeWithCtx(shutdownCtx context.Context) error {
 func StartBridge(isStandAlone bool) {
 	// create codec
 	cdc := app.MakeCodec()
-	// queue connector & http client
-	_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+	// task queue & http client
+	_queueConnector, err := queue.NewTaskQueue(helper.GetConfig())
+	if err != nil {
+		panic(fmt.Sprintf("Error creating task queue %v", err))
+	}
+
 	_queueConnector.StartWorker()
 
 	_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
@@ -146,6 +160,8 @@ func StartBridge(isStandAlone bool) {
 	services = append(services,
 		listener.NewListenerService(cdc, _queueConnector, _httpClient),
 		processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+		_txBroadcaster.RootchainTxManager(),
+		status.NewServer(cdc, _queueConnector, _httpClient, _txBroadcaster.RootchainTxManager()),
 	)
 
 	// sync group
@@ -172,6 +188,9 @@ func StartBridge(isStandAlone bool) {
 				logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
 			}
 
+			// stop task queue
+			_queueConnector.Stop()
+
 			// stop db instance
 			util.CloseBridgeDBInstance()
 
@@ -183,7 +202,7 @@ func StartBridge(isStandAlone bool) {
 	}()
 
 	// Start http client
-	err := _httpClient.Start()
+	err = _httpClient.Start()
 	if err != nil {
 		panic(fmt.Sprintf("Error connecting to server %v", err))
 	}
@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	allTasksFlag = "all"
+
+	// retries given to a replayed task, same as the listeners use
+	replayRetryCount = 3
+
+	// max error length shown by tasks list
+	listErrorLength = 80
+)
+
+// tasksCmd groups the commands handling failed bridge tasks
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Inspect, replay or drop bridge tasks which exhausted their retries (bridge must be stopped)",
+}
+
+var listTasksCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List failed tasks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withDeadLetterStore(func(store *queue.DeadLetterStore) error {
+			failedTasks, err := store.List()
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "UUID\tNAME\tATTEMPTS\tENQUEUED AT\tFAILED AT\tERROR")
+
+			for _, failedTask := range failedTasks {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\n",
+					failedTask.UUID,
+					failedTask.Name,
+					failedTask.Attempts,
+					formatTaskTime(failedTask.EnqueuedAt),
+					formatTaskTime(failedTask.FailedAt),
+					truncate(failedTask.Error, listErrorLength),
+				)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+var showTaskCmd = &cobra.Command{
+	Use:   "show <uuid>",
+	Short: "Show failed task with its arguments",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withDeadLetterStore(func(store *queue.DeadLetterStore) error {
+			failedTask, err := store.Get(args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(failedTask, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+
+			return nil
+		})
+	},
+}
+
+var replayTaskCmd = &cobra.Command{
+	Use:   "replay [uuid...]",
+	Short: "Send failed tasks back to the task queue and remove them from the failed list",
+	Args:  taskSelectionArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withDeadLetterStore(func(store *queue.DeadLetterStore) error {
+			failedTasks, err := selectFailedTasks(cmd, store, args)
+			if err != nil {
+				return err
+			}
+
+			taskQueue, err := queue.NewTaskQueue(helper.GetConfig())
+			if err != nil {
+				return err
+			}
+
+			for _, failedTask := range failedTasks {
+				if err := taskQueue.SendTask(failedTask.Signature(replayRetryCount)); err != nil {
+					return fmt.Errorf("replay task %s: %w", failedTask.UUID, err)
+				}
+
+				if err := store.Delete(failedTask.UUID); err != nil {
+					return err
+				}
+
+				fmt.Println("Replayed", failedTask.UUID, failedTask.Name)
+			}
+
+			return nil
+		})
+	},
+}
+
+var dropTaskCmd = &cobra.Command{
+	Use:   "drop [uuid...]",
+	Short: "Remove failed tasks without running them again",
+	Args:  taskSelectionArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withDeadLetterStore(func(store *queue.DeadLetterStore) error {
+			failedTasks, err := selectFailedTasks(cmd, store, args)
+			if err != nil {
+				return err
+			}
+
+			for _, failedTask := range failedTasks {
+				if err := store.Delete(failedTask.UUID); err != nil {
+					return err
+				}
+
+				fmt.Println("Dropped", failedTask.UUID, failedTask.Name)
+			}
+
+			return nil
+		})
+	},
+}
+
+// withDeadLetterStore opens the bridge db for the duration of fn
+func withDeadLetterStore(fn func(store *queue.DeadLetterStore) error) error {
+	defer util.CloseBridgeDBInstance()
+
+	return fn(queue.NewDeadLetterStore(util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))))
+}
+
+// taskSelectionArgs requires either task uuids or the --all flag
+func taskSelectionArgs(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool(allTasksFlag)
+	if all == (len(args) > 0) {
+		return errors.New("provide task uuids or --all")
+	}
+
+	return nil
+}
+
+// selectFailedTasks returns the failed tasks selected by args or --all
+func selectFailedTasks(cmd *cobra.Command, store *queue.DeadLetterStore, args []string) ([]*queue.FailedTask, error) {
+	if all, _ := cmd.Flags().GetBool(allTasksFlag); all {
+		return store.List()
+	}
+
+	failedTasks := make([]*queue.FailedTask, 0, len(args))
+
+	for _, taskUUID := range args {
+		failedTask, err := store.Get(taskUUID)
+		if err != nil {
+			return nil, fmt.Errorf("task %s: %w", taskUUID, err)
+		}
+
+		failedTasks = append(failedTasks, failedTask)
+	}
+
+	return failedTasks, nil
+}
+
+func formatTaskTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+
+	return s[:length-3] + "..."
+}
+
+func init() {
+	replayTaskCmd.Flags().Bool(allTasksFlag, false, "Replay all failed tasks")
+	dropTaskCmd.Flags().Bool(allTasksFlag, false, "Drop all failed tasks")
+
+	tasksCmd.AddCommand(
+		listTasksCmd,
+		showTaskCmd,
+		replayTaskCmd,
+		dropTaskCmd,
+	)
+
+	rootCmd.AddCommand(tasksCmd)
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bor "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -33,6 +35,9 @@ type TxBroadcaster struct {
 
 	lastSeqNo uint64
 	accNum    uint64
+
+	rootchainOnce      sync.Once
+	rootchainTxManager *RootchainTxManager
 }
 
 // NewTxBroadcaster creates new broadcaster
@@ -76,6 +81,8 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg, event interface{}, tes
 		WithChainID(chainID)
 
 	txResponse, err := helper.BuildAndBroadcastMsgs(tb.CliCtx, txBldr, []sdk.Msg{msg}, testOpts...)
+	recordHeimdallTx(msg, txResponse, err)
+
 	if err != nil || txResponse.Code != uint32(sdk.CodeOK) {
 		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err, "txResponse", txResponse.Code)
 
@@ -90,6 +97,11 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg, event interface{}, tes
 		}
 
 		// update seqNo for safety
+		if account.GetSequence() != tb.lastSeqNo {
+			tb.logger.Info("Recovered account sequence from heimdall", "previous", tb.lastSeqNo, "sequence", account.GetSequence())
+			sequenceRecoveries.Inc()
+		}
+
 		tb.lastSeqNo = account.GetSequence()
 
 		return txResponse, err
@@ -118,6 +130,8 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 
 	if err != nil {
 		tb.logger.Error("Error generating auth object", "error", err)
+		borTxErrors.Inc()
+
 		return err
 	}
 
@@ -128,6 +142,8 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	signedTx, err := auth.Signer(auth.From, rawTx)
 	if err != nil {
 		tb.logger.Error("Error signing the transaction", "error", err)
+		borTxErrors.Inc()
+
 		return err
 	}
 
@@ -140,11 +156,40 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	// broadcast transaction
 	if err := maticClient.SendTransaction(ctx, signedTx); err != nil {
 		tb.logger.Error("Error while broadcasting the transaction to maticchain", "error", err)
+		borTxErrors.Inc()
+
 		return err
 	}
 
 	return nil
 }
 
+// RootchainTxManager returns the manager tracking the transactions sent to rootchain,
+// it has to be started for stuck transactions to be replaced
+func (tb *TxBroadcaster) RootchainTxManager() *RootchainTxManager {
+	tb.rootchainOnce.Do(func() {
+		tb.rootchainTxManager = newRootchainTxManager(
+			tb.logger.With("module", rootchainTxManagerStr),
+			util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+			helper.GetMainClient(),
+			helper.GetFromAddress(),
+			helper.SignMainchainTx,
+			helper.GetConfig(),
+		)
+	})
+
+	return tb.rootchainTxManager
+}
+
 // BroadcastToRootchain broadcast to rootchain
-func (tb *TxBroadcaster) BroadcastToRootchain() {}
+func (tb *TxBroadcaster) BroadcastToRootchain(to common.Address, data []byte, description string) (common.Hash, error) {
+	txHash, err := tb.RootchainTxManager().Send(to, data, description)
+	if err != nil {
+		tb.logger.Error("Error while broadcasting the transaction to rootchain", "description", description, "error", err)
+		rootchainTxErrors.Inc()
+
+		return common.Hash{}, err
+	}
+
+	return txHash, nil
+}
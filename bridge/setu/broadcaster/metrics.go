@@ -0,0 +1,86 @@
+package broadcaster
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+var (
+	heimdallTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "heimdall_txs",
+		Help:      "The total number of transactions broadcast to heimdall",
+	}, []string{"route", "type"})
+
+	heimdallTxErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "heimdall_tx_errors",
+		Help:      "The total number of transactions rejected by heimdall, by codespace and code",
+	}, []string{"route", "type", "codespace", "code"})
+
+	sequenceRecoveries = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "heimdall_sequence_recoveries",
+		Help:      "The total number of times the account sequence was out of sync with heimdall and was refetched",
+	})
+
+	borTxErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "bor_tx_errors",
+		Help:      "The total number of transactions which could not be sent to bor",
+	})
+
+	rootchainTxs = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_txs",
+		Help:      "The total number of transactions sent to the root chain",
+	})
+
+	rootchainTxErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_tx_errors",
+		Help:      "The total number of transactions which could not be sent to the root chain",
+	})
+
+	rootchainTxReplacements = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_tx_replacements",
+		Help:      "The total number of stuck root chain transactions replaced with higher fees",
+	})
+
+	rootchainTxsFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_txs_finished",
+		Help:      "The total number of root chain transactions which reached a final status",
+	}, []string{"status"})
+)
+
+// recordHeimdallTx records the outcome of broadcasting msg to heimdall
+func recordHeimdallTx(msg sdk.Msg, txResponse sdk.TxResponse, err error) {
+	heimdallTxs.WithLabelValues(msg.Route(), msg.Type()).Inc()
+
+	if err == nil && txResponse.Code == uint32(sdk.CodeOK) {
+		return
+	}
+
+	// errors returned before reaching heimdall have no code
+	code := "none"
+	if txResponse.Code != uint32(sdk.CodeOK) {
+		code = strconv.FormatUint(uint64(txResponse.Code), 10)
+	}
+
+	heimdallTxErrors.WithLabelValues(msg.Route(), msg.Type(), txResponse.Codespace, code).Inc()
+}
@@ -0,0 +1,439 @@
+package broadcaster
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	rootchainTxManagerStr = "rootchain-tx-manager"
+
+	// interval at which pending rootchain transactions are checked
+	rootchainTxCheckInterval = 15 * time.Second
+
+	// finished rootchain transactions are kept this long for status reporting
+	rootchainTxRetention = 7 * 24 * time.Hour
+
+	// nodes only accept a replacement transaction when both fees are bumped by this percentage
+	minFeeBumpPercent = 10
+)
+
+// rootchainClient is the part of the eth client used to send rootchain transactions
+type rootchainClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// signTxFn signs a transaction for the given chain id
+type signTxFn func(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+// RootchainTxManager sends dynamic fee transactions to the rootchain, persists them in the
+// bridge db until they are mined and replaces the ones stuck in the mempool with higher fees
+type RootchainTxManager struct {
+	// Base service
+	cmn.BaseService
+
+	mu sync.Mutex
+
+	store   *rootchainTxStore
+	client  rootchainClient
+	from    common.Address
+	sign    signTxFn
+	chainID *big.Int
+
+	maxFee       *big.Int
+	bumpInterval time.Duration
+	bumpPercent  uint64
+	timeout      time.Duration
+
+	cancel context.CancelFunc
+}
+
+// newRootchainTxManager creates a rootchain transaction manager
+func newRootchainTxManager(logger log.Logger, db *leveldb.DB, client rootchainClient, from common.Address, sign signTxFn, conf helper.Configuration) *RootchainTxManager {
+	maxFee := conf.MainchainMaxGasPrice
+	// Check if configured or not, Use default in case of invalid value
+	if maxFee <= 0 {
+		maxFee = helper.DefaultMainchainMaxGasPrice
+	}
+
+	manager := &RootchainTxManager{
+		store:        &rootchainTxStore{db: db},
+		client:       client,
+		from:         from,
+		sign:         sign,
+		maxFee:       big.NewInt(maxFee),
+		bumpInterval: conf.MainchainTxBumpInterval,
+		bumpPercent:  conf.MainchainTxFeeBumpPercent,
+		timeout:      conf.EthRPCTimeout,
+	}
+
+	manager.BaseService = *cmn.NewBaseService(logger, rootchainTxManagerStr, manager)
+
+	return manager
+}
+
+// OnStart starts checking the pending transactions
+func (m *RootchainTxManager) OnStart() error {
+	if err := m.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go m.monitor(ctx)
+
+	return nil
+}
+
+// OnStop stops checking the pending transactions
+func (m *RootchainTxManager) OnStop() {
+	m.BaseService.OnStop()
+
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// Send sends a transaction calling to with data and returns its hash. A pending transaction
+// with the same call is not sent again, the hash of its latest version is returned instead.
+func (m *RootchainTxManager) Send(to common.Address, data []byte, description string) (common.Hash, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	pending, err := m.store.pending()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	nonce, err := m.client.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	for _, tx := range pending {
+		if tx.To == to && bytes.Equal(tx.Data, data) {
+			m.Logger.Info("Rootchain transaction already pending", "description", description, "nonce", tx.Nonce, "txHash", tx.Hashes[len(tx.Hashes)-1])
+			return tx.Hashes[len(tx.Hashes)-1], nil
+		}
+
+		// pending transactions dropped by the node keep their nonce
+		if tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+
+	gas, err := m.client.EstimateGas(ctx, ethereum.CallMsg{From: m.from, To: &to, Data: data})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	baseFee, suggestedTip, err := m.suggestFees(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	gasTipCap, gasFeeCap, err := initialFees(baseFee, suggestedTip, m.maxFee)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx := &RootchainTx{
+		Nonce:       nonce,
+		To:          to,
+		Data:        data,
+		Gas:         gas,
+		GasTipCap:   gasTipCap,
+		GasFeeCap:   gasFeeCap,
+		Description: description,
+		Status:      RootchainTxPending,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := m.broadcast(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	rootchainTxs.Inc()
+
+	txHash := tx.Hashes[len(tx.Hashes)-1]
+	m.Logger.Info("Sent rootchain transaction", "description", description, "nonce", nonce, "txHash", txHash, "gasTipCap", gasTipCap, "gasFeeCap", gasFeeCap)
+
+	return txHash, nil
+}
+
+// Transactions returns the transactions sent to the rootchain ordered by nonce
+func (m *RootchainTxManager) Transactions() ([]*RootchainTx, error) {
+	return m.store.list()
+}
+
+// broadcast signs the transaction with its current fees, sends it and persists it
+func (m *RootchainTxManager) broadcast(ctx context.Context, tx *RootchainTx) error {
+	if m.chainID == nil {
+		chainID, err := m.client.ChainID(ctx)
+		if err != nil {
+			return err
+		}
+
+		m.chainID = chainID
+	}
+
+	to := tx.To
+
+	signedTx, err := m.sign(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   m.chainID,
+		Nonce:     tx.Nonce,
+		GasTipCap: tx.GasTipCap,
+		GasFeeCap: tx.GasFeeCap,
+		Gas:       tx.Gas,
+		To:        &to,
+		Data:      tx.Data,
+	}), m.chainID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.client.SendTransaction(ctx, signedTx); err != nil {
+		return err
+	}
+
+	tx.Hashes = append(tx.Hashes, signedTx.Hash())
+	tx.LastSentAt = time.Now()
+
+	return m.store.put(tx)
+}
+
+// suggestFees returns the base fee of the latest block and the suggested priority fee
+func (m *RootchainTxManager) suggestFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	head, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if head.BaseFee == nil {
+		return nil, nil, errors.New("rootchain does not support dynamic fee transactions")
+	}
+
+	suggestedTip, err := m.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return head.BaseFee, suggestedTip, nil
+}
+
+// monitor checks the pending transactions until ctx is done
+func (m *RootchainTxManager) monitor(ctx context.Context) {
+	ticker := time.NewTicker(rootchainTxCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.checkPending()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkPending records the final status of mined transactions and replaces the ones
+// pending for longer than the bump interval
+func (m *RootchainTxManager) checkPending() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	txs, err := m.store.list()
+	if err != nil {
+		m.Logger.Error("Error while reading rootchain transactions", "error", err)
+		return
+	}
+
+	if len(txs) == 0 {
+		return
+	}
+
+	// nonce of the next transaction to be mined
+	minedNonce, err := m.client.NonceAt(ctx, m.from, nil)
+	if err != nil {
+		m.Logger.Error("Error while fetching rootchain nonce", "error", err)
+		return
+	}
+
+	for _, tx := range txs {
+		if tx.Status != RootchainTxPending {
+			if time.Since(tx.FinishedAt) > rootchainTxRetention {
+				if err := m.store.delete(tx.Nonce); err != nil {
+					m.Logger.Error("Error while deleting rootchain transaction", "nonce", tx.Nonce, "error", err)
+				}
+			}
+
+			continue
+		}
+
+		if err := m.checkTx(ctx, tx, minedNonce); err != nil {
+			m.Logger.Error("Error while checking rootchain transaction", "description", tx.Description, "nonce", tx.Nonce, "error", err)
+		}
+	}
+}
+
+// checkTx updates the status of a pending transaction or bumps its fees
+func (m *RootchainTxManager) checkTx(ctx context.Context, tx *RootchainTx, minedNonce uint64) error {
+	var receiptErr error
+
+	for _, hash := range tx.Hashes {
+		// nodes still indexing transactions return an error instead of not found
+		receipt, err := m.client.TransactionReceipt(ctx, hash)
+		if err != nil {
+			if !errors.Is(err, ethereum.NotFound) {
+				receiptErr = err
+			}
+
+			continue
+		}
+
+		tx.TxHash = hash
+		tx.BlockNumber = receipt.BlockNumber.Uint64()
+		tx.FinishedAt = time.Now()
+
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			tx.Status = RootchainTxSuccess
+			m.Logger.Info("Rootchain transaction succeeded", "description", tx.Description, "nonce", tx.Nonce, "txHash", hash, "blockNumber", tx.BlockNumber, "versions", len(tx.Hashes))
+		} else {
+			tx.Status = RootchainTxReverted
+			m.Logger.Error("Rootchain transaction reverted", "description", tx.Description, "nonce", tx.Nonce, "txHash", hash, "blockNumber", tx.BlockNumber)
+		}
+
+		rootchainTxsFinished.WithLabelValues(tx.Status).Inc()
+
+		return m.store.put(tx)
+	}
+
+	// the nonce was used but none of our versions was mined
+	if tx.Nonce < minedNonce {
+		if receiptErr != nil {
+			return receiptErr
+		}
+
+		tx.Status = RootchainTxReplaced
+		tx.FinishedAt = time.Now()
+
+		m.Logger.Error("Rootchain transaction nonce used by another transaction", "description", tx.Description, "nonce", tx.Nonce)
+		rootchainTxsFinished.WithLabelValues(tx.Status).Inc()
+
+		return m.store.put(tx)
+	}
+
+	if time.Since(tx.LastSentAt) < m.bumpInterval {
+		return nil
+	}
+
+	baseFee, suggestedTip, err := m.suggestFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	gasTipCap, gasFeeCap, err := bumpFees(tx.GasTipCap, tx.GasFeeCap, baseFee, suggestedTip, m.maxFee, m.bumpPercent)
+	if err != nil {
+		return err
+	}
+
+	previousTipCap, previousFeeCap := tx.GasTipCap, tx.GasFeeCap
+	tx.GasTipCap, tx.GasFeeCap = gasTipCap, gasFeeCap
+
+	if err := m.broadcast(ctx, tx); err != nil {
+		tx.GasTipCap, tx.GasFeeCap = previousTipCap, previousFeeCap
+		return err
+	}
+
+	rootchainTxReplacements.Inc()
+
+	m.Logger.Info("Replaced stuck rootchain transaction", "description", tx.Description, "nonce", tx.Nonce, "txHash", tx.Hashes[len(tx.Hashes)-1], "gasTipCap", gasTipCap, "gasFeeCap", gasFeeCap, "versions", len(tx.Hashes))
+
+	return nil
+}
+
+// initialFees returns the fees of a new transaction, allowing the base fee to double
+// before the transaction stops being includable
+func initialFees(baseFee *big.Int, suggestedTip *big.Int, maxFee *big.Int) (*big.Int, *big.Int, error) {
+	if baseFee.Cmp(maxFee) > 0 {
+		return nil, nil, fmt.Errorf("base fee is more than max_gas_price, baseFee = %v, maxGasPrice = %v", baseFee, maxFee)
+	}
+
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), suggestedTip)
+	if gasFeeCap.Cmp(maxFee) > 0 {
+		gasFeeCap = new(big.Int).Set(maxFee)
+	}
+
+	gasTipCap := new(big.Int).Set(suggestedTip)
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		gasTipCap = new(big.Int).Set(gasFeeCap)
+	}
+
+	return gasTipCap, gasFeeCap, nil
+}
+
+// bumpFees returns the fees of a replacement transaction. Both fees are increased by at least
+// percent and follow the current suggestion if it is higher, without exceeding maxFee.
+func bumpFees(gasTipCap *big.Int, gasFeeCap *big.Int, baseFee *big.Int, suggestedTip *big.Int, maxFee *big.Int, percent uint64) (*big.Int, *big.Int, error) {
+	newTipCap := bigMax(increase(gasTipCap, percent), suggestedTip)
+	newFeeCap := bigMax(increase(gasFeeCap, percent), new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), newTipCap))
+
+	if newFeeCap.Cmp(maxFee) > 0 {
+		newFeeCap = new(big.Int).Set(maxFee)
+	}
+
+	if newTipCap.Cmp(newFeeCap) > 0 {
+		newTipCap = new(big.Int).Set(newFeeCap)
+	}
+
+	if newTipCap.Cmp(increase(gasTipCap, minFeeBumpPercent)) < 0 || newFeeCap.Cmp(increase(gasFeeCap, minFeeBumpPercent)) < 0 {
+		return nil, nil, fmt.Errorf("cannot bump fees above max_gas_price, gasFeeCap = %v, maxGasPrice = %v", gasFeeCap, maxFee)
+	}
+
+	return newTipCap, newFeeCap, nil
+}
+
+// increase returns value increased by percent, rounded up
+func increase(value *big.Int, percent uint64) *big.Int {
+	result := new(big.Int).Mul(value, new(big.Int).SetUint64(100+percent))
+	result.Add(result, big.NewInt(99))
+
+	return result.Div(result, big.NewInt(100))
+}
+
+func bigMax(x *big.Int, y *big.Int) *big.Int {
+	if x.Cmp(y) >= 0 {
+		return x
+	}
+
+	return new(big.Int).Set(y)
+}
@@ -0,0 +1,107 @@
+package broadcaster
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	rootchainTxPrefix = "rootchain-tx-" // + zero padded nonce
+
+	// RootchainTxPending is a transaction which is not mined yet
+	RootchainTxPending = "pending"
+	// RootchainTxSuccess is a transaction which was mined and succeeded
+	RootchainTxSuccess = "success"
+	// RootchainTxReverted is a transaction which was mined and reverted
+	RootchainTxReverted = "reverted"
+	// RootchainTxReplaced is a transaction whose nonce was used by a transaction not sent by the bridge
+	RootchainTxReplaced = "replaced"
+)
+
+// RootchainTx is a transaction sent to the rootchain by the bridge
+type RootchainTx struct {
+	Nonce       uint64         `json:"nonce"`
+	To          common.Address `json:"to"`
+	Data        hexutil.Bytes  `json:"data"`
+	Gas         uint64         `json:"gas"`
+	GasTipCap   *big.Int       `json:"gasTipCap"`
+	GasFeeCap   *big.Int       `json:"gasFeeCap"`
+	Description string         `json:"description"`
+
+	// hashes of every version of the transaction, the last one has the highest fees
+	Hashes []common.Hash `json:"hashes"`
+
+	Status      string      `json:"status"`
+	TxHash      common.Hash `json:"txHash,omitempty"` // hash of the mined version
+	BlockNumber uint64      `json:"blockNumber,omitempty"`
+
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSentAt time.Time `json:"lastSentAt"`
+	FinishedAt time.Time `json:"finishedAt,omitempty"`
+}
+
+// rootchainTxStore persists rootchain transactions in the bridge db, keyed by nonce
+type rootchainTxStore struct {
+	db *leveldb.DB
+}
+
+func rootchainTxKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", rootchainTxPrefix, nonce))
+}
+
+func (s *rootchainTxStore) put(tx *RootchainTx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(rootchainTxKey(tx.Nonce), value, nil)
+}
+
+func (s *rootchainTxStore) delete(nonce uint64) error {
+	return s.db.Delete(rootchainTxKey(nonce), nil)
+}
+
+// list returns the stored transactions ordered by nonce
+func (s *rootchainTxStore) list() ([]*RootchainTx, error) {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(rootchainTxPrefix)), nil)
+	defer iter.Release()
+
+	txs := make([]*RootchainTx, 0)
+
+	for iter.Next() {
+		tx := new(RootchainTx)
+		if err := json.Unmarshal(iter.Value(), tx); err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, iter.Error()
+}
+
+// pending returns the transactions which are not mined yet ordered by nonce
+func (s *rootchainTxStore) pending() ([]*RootchainTx, error) {
+	txs, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]*RootchainTx, 0, len(txs))
+
+	for _, tx := range txs {
+		if tx.Status == RootchainTxPending {
+			pending = append(pending, tx)
+		}
+	}
+
+	return pending, nil
+}
@@ -0,0 +1,163 @@
+package broadcaster
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func newRootchainTestManager(t *testing.T) (*RootchainTxManager, *simulated.Backend) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.EthRPCTimeout = 5 * time.Second
+
+	manager := newRootchainTxManager(log.NewNopLogger(), db, backend.Client(), from, keySigner(key), conf)
+
+	return manager, backend
+}
+
+func keySigner(key *ecdsa.PrivateKey) signTxFn {
+	return func(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+		return types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	}
+}
+
+func TestRootchainTxManagerSend(t *testing.T) {
+	t.Parallel()
+
+	manager, backend := newRootchainTestManager(t)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	txHash, err := manager.Send(to, []byte{0x01}, "first")
+	require.NoError(t, err)
+
+	// the same call is not sent twice while pending
+	sameHash, err := manager.Send(to, []byte{0x01}, "first")
+	require.NoError(t, err)
+	require.Equal(t, txHash, sameHash)
+
+	_, err = manager.Send(to, []byte{0x02}, "second")
+	require.NoError(t, err)
+
+	backend.Commit()
+	manager.checkPending()
+
+	txs, err := manager.Transactions()
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+
+	require.Equal(t, uint64(0), txs[0].Nonce)
+	require.Equal(t, RootchainTxSuccess, txs[0].Status)
+	require.Equal(t, txHash, txs[0].TxHash)
+	require.Equal(t, uint64(1), txs[0].BlockNumber)
+
+	require.Equal(t, uint64(1), txs[1].Nonce)
+	require.Equal(t, RootchainTxSuccess, txs[1].Status)
+}
+
+func TestRootchainTxManagerBump(t *testing.T) {
+	t.Parallel()
+
+	manager, backend := newRootchainTestManager(t)
+	manager.bumpInterval = 0
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	txHash, err := manager.Send(to, []byte{0x01}, "stuck")
+	require.NoError(t, err)
+
+	// the pending transaction is replaced with higher fees
+	manager.checkPending()
+
+	txs, err := manager.Transactions()
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Len(t, txs[0].Hashes, 2)
+	require.Equal(t, RootchainTxPending, txs[0].Status)
+
+	replacement := txs[0].Hashes[1]
+	require.NotEqual(t, txHash, replacement)
+
+	backend.Commit()
+	manager.checkPending()
+
+	txs, err = manager.Transactions()
+	require.NoError(t, err)
+	require.Equal(t, RootchainTxSuccess, txs[0].Status)
+	require.Equal(t, replacement, txs[0].TxHash)
+
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), replacement)
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
+func TestInitialFees(t *testing.T) {
+	t.Parallel()
+
+	tipCap, feeCap, err := initialFees(big.NewInt(100), big.NewInt(10), big.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), tipCap)
+	require.Equal(t, big.NewInt(210), feeCap)
+
+	// capped by the max gas price
+	tipCap, feeCap, err = initialFees(big.NewInt(100), big.NewInt(10), big.NewInt(150))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), tipCap)
+	require.Equal(t, big.NewInt(150), feeCap)
+
+	_, _, err = initialFees(big.NewInt(200), big.NewInt(10), big.NewInt(150))
+	require.Error(t, err)
+}
+
+func TestBumpFees(t *testing.T) {
+	t.Parallel()
+
+	tipCap, feeCap, err := bumpFees(big.NewInt(10), big.NewInt(210), big.NewInt(100), big.NewInt(5), big.NewInt(1000), 20)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(12), tipCap)
+	require.Equal(t, big.NewInt(252), feeCap)
+
+	// follows the current fees when they grew more than the bump
+	tipCap, feeCap, err = bumpFees(big.NewInt(10), big.NewInt(210), big.NewInt(300), big.NewInt(50), big.NewInt(1000), 20)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(50), tipCap)
+	require.Equal(t, big.NewInt(650), feeCap)
+
+	// capped by the max gas price but still a valid replacement
+	_, feeCap, err = bumpFees(big.NewInt(10), big.NewInt(210), big.NewInt(100), big.NewInt(5), big.NewInt(240), 20)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(240), feeCap)
+
+	// not enough room below the max gas price to replace
+	_, _, err = bumpFees(big.NewInt(10), big.NewInt(210), big.NewInt(100), big.NewInt(5), big.NewInt(220), 20)
+	require.Error(t, err)
+}
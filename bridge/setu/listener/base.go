@@ -66,7 +66,7 @@ type BaseListener struct {
 	cliCtx cliContext.CLIContext
 
 	// queue connector
-	queueConnector *queue.QueueConnector
+	queueConnector queue.TaskQueue
 
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
@@ -81,7 +81,7 @@ type blockHeader struct {
 }
 
 // NewBaseListener creates a new BaseListener.
-func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, chainClient *ethclient.Client, name string, impl Listener) *BaseListener {
+func NewBaseListener(cdc *codec.Codec, queueConnector queue.TaskQueue, httpClient *httpClient.HTTP, chainClient *ethclient.Client, name string, impl Listener) *BaseListener {
 	logger := util.Logger().With("service", "listener", "module", name)
 
 	contractCaller, err := helper.NewContractCaller()
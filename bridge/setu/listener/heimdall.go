@@ -18,7 +18,7 @@ import (
 )
 
 const (
-	heimdallLastBlockKey = "heimdall-last-block" // storage key
+	HeimdallLastBlockKey = "heimdall-last-block" // storage key
 )
 
 // HeimdallListener - Listens to and process events from heimdall
@@ -45,8 +45,17 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
-	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
-	hl.StartPolling(headerCtx, pollInterval, nil)
+	switch helper.GetConfig().HeimdallListenerMode {
+	case HeimdallSubscribeMode:
+		hl.Logger.Info("Start subscribing for events", "fallbackPollInterval", pollInterval)
+		hl.StartEventSubscription(headerCtx, pollInterval)
+	case "", HeimdallPollMode:
+		hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
+		hl.StartPolling(headerCtx, pollInterval, nil)
+	default:
+		hl.Logger.Error("Unknown heimdall listener mode, polling for events", "mode", helper.GetConfig().HeimdallListenerMode, "pollInterval", pollInterval)
+		hl.StartPolling(headerCtx, pollInterval, nil)
+	}
 
 	return nil
 }
@@ -75,70 +84,7 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	for {
 		select {
 		case <-ticker.C:
-			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
-			if err != nil {
-				hl.Logger.Error("Error fetching from and toBlock, skipping events query", "fromBlock", fromBlock, "toBlock", toBlock, "error", err)
-			} else if fromBlock < toBlock {
-
-				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
-
-				// Querying and processing Begin events
-				for i := fromBlock; i <= toBlock; i++ {
-					if i > math.MaxInt64 {
-						hl.Logger.Error("Block number out of range for int64", "blockNumber", i)
-						continue
-					}
-					//nolint:contextcheck
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
-					}
-					for _, event := range events {
-						//nolint:gosec
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
-					}
-				}
-
-				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
-				/* 		for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
-
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
-
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
-						}
-					}
-				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
-				}
-			}
+			hl.pollBlocks()
 
 		case <-ctx.Done():
 			hl.Logger.Info("Polling stopped")
@@ -149,6 +95,60 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	}
 }
 
+// pollBlocks processes the begin block events of the blocks committed since the last processed one
+func (hl *HeimdallListener) pollBlocks() {
+	fromBlock, toBlock, err := hl.fetchFromAndToBlock()
+	if err != nil {
+		hl.Logger.Error("Error fetching from and toBlock, skipping events query", "fromBlock", fromBlock, "toBlock", toBlock, "error", err)
+	} else if fromBlock < toBlock {
+
+		hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+
+		// Querying and processing Begin events
+		for i := fromBlock; i <= toBlock; i++ {
+			hl.processBeginBlockEvents(i)
+		}
+
+		// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
+		/* 		for _, eventType := range eventTypes {
+			var query []string
+			query = append(query, eventType)
+			query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
+			query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
+
+			limit := 50
+			for page := 1; page > 0; {
+				searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
+				hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
+
+				if err != nil {
+					hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
+					break
+				}
+
+				for _, tx := range searchResult.Txs {
+					for _, log := range tx.Logs {
+						event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
+							return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
+						})
+						if event != nil {
+							hl.ProcessEvent(*event, tx)
+						}
+					}
+				}
+
+				if len(searchResult.Txs) == limit {
+					page = page + 1
+				} else {
+					page = 0
+				}
+			}
+		} */
+		// set last block to storage
+		hl.setLastBlock(toBlock)
+	}
+}
+
 func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	// toBlock - get latest blockheight from heimdall node
 	fromBlock := uint64(0)
@@ -166,29 +166,69 @@ func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	toBlock = big.NewInt(0).SetInt64(nodeStatus.SyncInfo.LatestBlockHeight).Uint64()
 
 	// fromBlock - get last block from storage
-	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
-	if hasLastBlock {
-		lastBlockBytes, e := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
-		if e != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", e)
-			return fromBlock, toBlock, e
-		}
+	lastBlock, hasLastBlock, err := hl.fetchLastBlock()
+	if err != nil {
+		toBlock = 0
+		return fromBlock, toBlock, err
+	}
 
-		//nolint:gosec
-		if result, e := strconv.ParseUint(string(lastBlockBytes), 10, 64); e == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = result + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-
-			return fromBlock, toBlock, e
-		}
+	if hasLastBlock {
+		fromBlock = lastBlock + 1
 	}
 
 	return fromBlock, toBlock, nil
 }
 
+// fetchLastBlock returns the last heimdall block processed by the listener, if any
+func (hl *HeimdallListener) fetchLastBlock() (uint64, bool, error) {
+	hasLastBlock, _ := hl.storageClient.Has([]byte(HeimdallLastBlockKey), nil)
+	if !hasLastBlock {
+		return 0, false, nil
+	}
+
+	lastBlockBytes, err := hl.storageClient.Get([]byte(HeimdallLastBlockKey), nil)
+	if err != nil {
+		hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
+		return 0, false, err
+	}
+
+	result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
+		return 0, false, err
+	}
+
+	hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
+
+	return result, true, nil
+}
+
+// setLastBlock stores the last heimdall block processed by the listener
+func (hl *HeimdallListener) setLastBlock(height uint64) {
+	if err := hl.storageClient.Put([]byte(HeimdallLastBlockKey), []byte(strconv.FormatUint(height, 10)), nil); err != nil {
+		hl.Logger.Error("hl.storageClient.Put", "Error", err)
+	}
+}
+
+// processBeginBlockEvents queries and processes begin block events of a heimdall block
+func (hl *HeimdallListener) processBeginBlockEvents(height uint64) {
+	if height > math.MaxInt64 {
+		hl.Logger.Error("Block number out of range for int64", "blockNumber", height)
+		return
+	}
+
+	//nolint:contextcheck
+	events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(height))
+	if err != nil {
+		hl.Logger.Error("Error fetching begin block events", "error", err)
+	}
+
+	for _, event := range events {
+		//nolint:gosec
+		hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(height))
+	}
+}
+
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
 func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) {
 	hl.Logger.Info("Received block event from Heimdall", "eventType", event.Type)
@@ -231,7 +271,7 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 	hl.Logger.Info("Sending block level task", "taskName", taskName, "currentTime", time.Now(), "blockHeight", blockHeight)
 
 	// send task
-	_, err := hl.queueConnector.Server.SendTask(signature)
+	err := hl.queueConnector.SendTask(signature)
 	if err != nil {
 		hl.Logger.Error("Error sending block level task", "taskName", taskName, "blockHeight", blockHeight, "error", err)
 	}
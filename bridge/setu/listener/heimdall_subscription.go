@@ -0,0 +1,291 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// HeimdallPollMode queries begin block events of every heimdall block on each poll interval
+	HeimdallPollMode = "poll"
+
+	// HeimdallSubscribeMode receives heimdall blocks from the tendermint event bus as they are committed
+	HeimdallSubscribeMode = "subscribe"
+
+	// subscriber name on the tendermint event bus
+	heimdallSubscriber = "bridge-heimdall-listener"
+
+	// NewBlock query of the listener. The websocket client keys subscriptions by query string and
+	// helper.GetBeginBlockEvents unsubscribes tm.event='NewBlock' when done, so the listener uses
+	// an equivalent query with a different spelling
+	heimdallNewBlockQuery = "tm.event = 'NewBlock'"
+
+	// events buffered by the websocket client, dropped blocks are backfilled
+	heimdallEventsCapacity = 100
+
+	// the subscription is considered broken when no event is received for this long
+	heimdallSubscriptionTimeout = time.Minute
+
+	// polls of the fallback before subscribing again after a failure, the first one right away and
+	// the next ones on each poll interval
+	heimdallResubscribePolls = 5
+)
+
+// heimdallTxQueries are the Tx queries of the listener, one per msg whose side tx result is processed
+// by the bridge: checkpoint, event-record, tick and span (v1 and v2). The results are begin block events of the
+// next blocks, a tx of a block missing from the cursor backfills the block
+var heimdallTxQueries = []string{
+	"tm.event = 'Tx' AND message.action = 'checkpoint'",
+	"tm.event = 'Tx' AND message.action = 'event-record'",
+	"tm.event = 'Tx' AND message.action = 'tick'",
+	"tm.event = 'Tx' AND message.action = 'propose-span'",
+	"tm.event = 'Tx' AND message.action = 'propose-span-v2'",
+}
+
+// heimdallEventsClient is the part of the tendermint rpc client used by the event subscription
+type heimdallEventsClient interface {
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error)
+	Unsubscribe(ctx context.Context, subscriber, query string) error
+	BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
+}
+
+// heimdallSubscription processes the begin block events of the heimdall blocks received from the
+// event bus, backfilling the blocks missing from the listener cursor
+type heimdallSubscription struct {
+	logger log.Logger
+	client heimdallEventsClient
+
+	// listener cursor, the last processed block
+	fetchLastBlock func() (uint64, bool, error)
+	setLastBlock   func(height uint64)
+
+	processEvent func(event sdk.StringEvent, height int64)
+
+	// pollOnce processes the blocks committed since the last processed one, while the subscription
+	// is unavailable
+	pollOnce func()
+
+	timeout          time.Duration
+	pollInterval     time.Duration
+	resubscribePolls int
+}
+
+// StartEventSubscription processes heimdall blocks as they are committed, falling back to
+// polling while the event subscription is unavailable
+func (hl *HeimdallListener) StartEventSubscription(ctx context.Context, pollInterval time.Duration) {
+	subscription := &heimdallSubscription{
+		logger:           hl.Logger,
+		client:           hl.httpClient,
+		fetchLastBlock:   hl.fetchLastBlock,
+		setLastBlock:     hl.setLastBlock,
+		processEvent:     hl.ProcessBlockEvent,
+		pollOnce:         hl.pollBlocks,
+		timeout:          heimdallSubscriptionTimeout,
+		pollInterval:     pollInterval,
+		resubscribePolls: heimdallResubscribePolls,
+	}
+
+	subscription.run(ctx)
+}
+
+// run subscribes to the heimdall events until ctx is done, polling resubscribePolls times after
+// each subscription failure
+func (s *heimdallSubscription) run(ctx context.Context) {
+	for {
+		err := s.subscribe(ctx)
+		if ctx.Err() != nil {
+			s.logger.Info("Event subscription stopped")
+			return
+		}
+
+		s.logger.Error("Heimdall event subscription failed, falling back to polling", "error", err,
+			"resubscribeIn", time.Duration(s.resubscribePolls-1)*s.pollInterval)
+
+		s.pollFallback(ctx)
+	}
+}
+
+// pollFallback polls right away and then on each poll interval, resubscribePolls times in total
+// or until ctx is done
+func (s *heimdallSubscription) pollFallback(ctx context.Context) {
+	s.pollOnce()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for polls := 1; polls < s.resubscribePolls; polls++ {
+		select {
+		case <-ticker.C:
+			s.pollOnce()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribe processes new blocks and txs until the subscription fails or ctx is done
+func (s *heimdallSubscription) subscribe(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queries := append([]string{heimdallNewBlockQuery}, heimdallTxQueries...)
+	subscribed := make([]string, 0, len(queries))
+
+	defer func() {
+		for _, query := range subscribed {
+			if err := s.client.Unsubscribe(context.Background(), heimdallSubscriber, query); err != nil {
+				s.logger.Error("Error unsubscribing from heimdall events", "query", query, "error", err)
+			}
+		}
+	}()
+
+	// the websocket client returns a channel per query
+	eventCh := make(chan ctypes.ResultEvent)
+
+	for _, query := range queries {
+		queryCh, err := s.client.Subscribe(subCtx, heimdallSubscriber, query, heimdallEventsCapacity)
+		if err != nil {
+			return fmt.Errorf("subscribing to %s: %w", query, err)
+		}
+
+		subscribed = append(subscribed, query)
+
+		go forwardEvents(subCtx, queryCh, eventCh)
+	}
+
+	s.logger.Info("Subscribed to heimdall events", "queries", queries)
+
+	timer := time.NewTimer(s.timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case event := <-eventCh:
+			switch data := event.Data.(type) {
+			case tmTypes.EventDataNewBlock:
+				s.processNewBlock(data)
+			case tmTypes.EventDataTx:
+				s.processTx(data)
+			default:
+				s.logger.Debug("Unexpected event data", "query", event.Query)
+				continue
+			}
+
+			if !timer.Stop() {
+				<-timer.C
+			}
+
+			timer.Reset(s.timeout)
+		case <-timer.C:
+			// the websocket client never closes the channels, a dropped connection stops the events
+			return errors.New("no event received within subscription timeout")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// forwardEvents sends the events of a query to out until ctx is done
+func forwardEvents(ctx context.Context, in <-chan ctypes.ResultEvent, out chan<- ctypes.ResultEvent) {
+	for {
+		select {
+		case event := <-in:
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// processNewBlock processes a block received from the event bus, after backfilling the blocks
+// missed since the last processed one (reconnects, polling fallback, dropped events)
+func (s *heimdallSubscription) processNewBlock(newBlock tmTypes.EventDataNewBlock) {
+	if newBlock.Block == nil || newBlock.Block.Height <= 0 {
+		return
+	}
+
+	height := uint64(newBlock.Block.Height)
+
+	lastBlock, hasLastBlock, err := s.fetchLastBlock()
+	if err != nil {
+		return
+	}
+
+	if hasLastBlock {
+		// already processed while polling, or backfilled
+		if lastBlock >= height {
+			return
+		}
+
+		if !s.backfill(lastBlock, height-1) {
+			return
+		}
+	}
+
+	for _, event := range newBlock.ResultBeginBlock.GetEvents() {
+		s.processEvent(sdk.StringifyEvent(event), newBlock.Block.Height)
+	}
+
+	s.setLastBlock(height)
+}
+
+// processTx backfills the block of a tx of interest when its NewBlock event was dropped. The side tx
+// result of the tx is processed with the begin block events of a next block
+func (s *heimdallSubscription) processTx(tx tmTypes.EventDataTx) {
+	if tx.Height <= 0 {
+		return
+	}
+
+	s.logger.Debug("Received heimdall tx", "height", tx.Height, "txHash", fmt.Sprintf("%X", tmTypes.Tx(tx.Tx).Hash()))
+
+	lastBlock, hasLastBlock, err := s.fetchLastBlock()
+	if err != nil || !hasLastBlock {
+		return
+	}
+
+	s.backfill(lastBlock, uint64(tx.Height))
+}
+
+// backfill processes the begin block events of the blocks after lastBlock up to toBlock, moving the
+// cursor block by block. It returns false if a block could not be fetched, the next event retries it
+func (s *heimdallSubscription) backfill(lastBlock uint64, toBlock uint64) bool {
+	if lastBlock >= toBlock {
+		return true
+	}
+
+	s.logger.Info("Backfilling missed heimdall blocks", "fromBlock", lastBlock+1, "toBlock", toBlock)
+
+	for height := lastBlock + 1; height <= toBlock; height++ {
+		//nolint:gosec
+		blockHeight := int64(height)
+
+		blockResults, err := s.client.BlockResults(&blockHeight)
+		if err != nil || blockResults == nil || blockResults.Results == nil {
+			s.logger.Error("Error fetching begin block events to backfill", "height", height, "error", err)
+			return false
+		}
+
+		for _, event := range blockResults.Results.BeginBlock.GetEvents() {
+			s.processEvent(sdk.StringifyEvent(event), blockHeight)
+		}
+
+		s.setLastBlock(height)
+	}
+
+	return true
+}
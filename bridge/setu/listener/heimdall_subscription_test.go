@@ -0,0 +1,235 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/state"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// testEventsClient serves the begin block events of its blocks, and a first NewBlock event on subscription
+type testEventsClient struct {
+	mu sync.Mutex
+
+	blocks       map[int64][]abci.Event
+	firstBlock   *tmTypes.EventDataNewBlock
+	subscribed   []string
+	unsubscribed []string
+}
+
+func (c *testEventsClient) Subscribe(_ context.Context, _, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.subscribed = append(c.subscribed, query)
+
+	ch := make(chan ctypes.ResultEvent, outCapacity[0])
+	if query == heimdallNewBlockQuery && c.firstBlock != nil {
+		ch <- ctypes.ResultEvent{Query: query, Data: *c.firstBlock}
+		c.firstBlock = nil
+	}
+
+	return ch, nil
+}
+
+func (c *testEventsClient) Unsubscribe(_ context.Context, _, query string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.unsubscribed = append(c.unsubscribed, query)
+
+	return nil
+}
+
+func (c *testEventsClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	events, ok := c.blocks[*height]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+
+	return &ctypes.ResultBlockResults{
+		Height:  *height,
+		Results: &state.ABCIResponses{BeginBlock: &abci.ResponseBeginBlock{Events: events}},
+	}, nil
+}
+
+// testCursor is the listener cursor and the heights of the processed events
+type testCursor struct {
+	mu sync.Mutex
+
+	lastBlock    uint64
+	hasLastBlock bool
+	processed    []int64
+}
+
+func newTestSubscription(client *testEventsClient, cursor *testCursor) *heimdallSubscription {
+	return &heimdallSubscription{
+		logger: log.NewNopLogger(),
+		client: client,
+		fetchLastBlock: func() (uint64, bool, error) {
+			cursor.mu.Lock()
+			defer cursor.mu.Unlock()
+
+			return cursor.lastBlock, cursor.hasLastBlock, nil
+		},
+		setLastBlock: func(height uint64) {
+			cursor.mu.Lock()
+			defer cursor.mu.Unlock()
+
+			cursor.lastBlock, cursor.hasLastBlock = height, true
+		},
+		processEvent: func(_ sdk.StringEvent, height int64) {
+			cursor.mu.Lock()
+			defer cursor.mu.Unlock()
+
+			cursor.processed = append(cursor.processed, height)
+		},
+		pollOnce:         func() {},
+		timeout:          heimdallSubscriptionTimeout,
+		pollInterval:     time.Second,
+		resubscribePolls: heimdallResubscribePolls,
+	}
+}
+
+func testNewBlock(height int64) tmTypes.EventDataNewBlock {
+	return tmTypes.EventDataNewBlock{
+		Block:            &tmTypes.Block{Header: tmTypes.Header{Height: height}},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{{Type: "checkpoint"}}},
+	}
+}
+
+func TestHeimdallSubscriptionBackfill(t *testing.T) {
+	t.Parallel()
+
+	checkpointEvents := []abci.Event{{Type: "checkpoint"}}
+	client := &testEventsClient{blocks: map[int64][]abci.Event{
+		6:  checkpointEvents,
+		7:  checkpointEvents,
+		9:  checkpointEvents,
+		10: checkpointEvents,
+	}}
+	cursor := &testCursor{lastBlock: 5, hasLastBlock: true}
+	subscription := newTestSubscription(client, cursor)
+
+	// the blocks missed since the cursor are processed before the new one
+	subscription.processNewBlock(testNewBlock(8))
+	require.Equal(t, []int64{6, 7, 8}, cursor.processed)
+	require.Equal(t, uint64(8), cursor.lastBlock)
+
+	// a block already processed is skipped
+	subscription.processNewBlock(testNewBlock(8))
+	require.Equal(t, []int64{6, 7, 8}, cursor.processed)
+
+	// a tx of a dropped block backfills up to its block
+	subscription.processTx(tmTypes.EventDataTx{TxResult: tmTypes.TxResult{Height: 10}})
+	require.Equal(t, []int64{6, 7, 8, 9, 10}, cursor.processed)
+	require.Equal(t, uint64(10), cursor.lastBlock)
+
+	// a block missing from the node stops the backfill, the cursor does not skip it
+	subscription.processNewBlock(testNewBlock(12))
+	require.Equal(t, []int64{6, 7, 8, 9, 10}, cursor.processed)
+	require.Equal(t, uint64(10), cursor.lastBlock)
+
+	// without a cursor, the listener starts from the new block
+	cursor = &testCursor{}
+	subscription = newTestSubscription(client, cursor)
+	subscription.processNewBlock(testNewBlock(20))
+	require.Equal(t, []int64{20}, cursor.processed)
+	require.Equal(t, uint64(20), cursor.lastBlock)
+}
+
+func TestHeimdallSubscriptionFallbackToPolling(t *testing.T) {
+	t.Parallel()
+
+	block := testNewBlock(1)
+	client := &testEventsClient{firstBlock: &block}
+	cursor := &testCursor{}
+	subscription := newTestSubscription(client, cursor)
+	subscription.timeout = 50 * time.Millisecond
+	subscription.pollInterval = 10 * time.Millisecond
+	subscription.resubscribePolls = 3
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the events stop after the first block, as on a dropped websocket connection. The listener
+	// polls resubscribePolls times, subscribes again, and stops on the second poll of the second fallback
+	polls := 0
+	subscription.pollOnce = func() {
+		polls++
+		if polls == 5 {
+			cancel()
+		}
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		subscription.run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not fall back to polling")
+	}
+
+	require.Equal(t, 5, polls)
+	require.Equal(t, []int64{1}, cursor.processed)
+
+	// each subscription covers the new blocks and the txs, and is dropped before polling
+	queries := append([]string{heimdallNewBlockQuery}, heimdallTxQueries...)
+	require.Equal(t, append(append([]string{}, queries...), queries...), client.subscribed)
+	require.ElementsMatch(t, client.subscribed, client.unsubscribed)
+}
+
+func TestHeimdallSubscriptionPollFallback(t *testing.T) {
+	t.Parallel()
+
+	subscription := newTestSubscription(&testEventsClient{}, &testCursor{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the first poll does not wait for the poll interval
+	polls := 0
+	subscription.pollInterval = time.Hour
+	subscription.pollOnce = func() {
+		polls++
+
+		cancel()
+	}
+
+	start := time.Now()
+
+	subscription.pollFallback(ctx)
+	require.Equal(t, 1, polls)
+	require.Less(t, time.Since(start), time.Second)
+
+	// the next polls run on the ticker, resubscribePolls times in total
+	polls = 0
+	subscription.pollInterval = 10 * time.Millisecond
+	subscription.resubscribePolls = 3
+	subscription.pollOnce = func() {
+		polls++
+	}
+
+	start = time.Now()
+
+	subscription.pollFallback(context.Background())
+	require.Equal(t, 3, polls)
+	require.GreaterOrEqual(t, time.Since(start), 2*subscription.pollInterval)
+}
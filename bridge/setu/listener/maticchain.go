@@ -75,7 +75,7 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 
 	ml.Logger.Debug("Sending task", "taskname", taskName, "currentTime", time.Now(), "delayTime", eta)
 
-	_, err := ml.queueConnector.Server.SendTask(signature)
+	err := ml.queueConnector.SendTask(signature)
 	if err != nil {
 		ml.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
@@ -31,12 +31,17 @@ type RootChainListener struct {
 	stakingInfoAbi *abi.ABI
 	stateSenderAbi *abi.ABI
 
-	// For self-heal, Will be only initialised if sub_graph_url is provided
-	subGraphClient *subGraphClient
+	// For self-heal, Will be only initialised if enable_self_heal is set.
+	// Queries the sub graph if sub_graph_url is provided, L1 logs otherwise
+	selfHealSource selfHealSource
+
+	// hashes of the last processed blocks, to rewind on reorgs
+	reorgDetector *reorgDetector
 }
 
 const (
-	lastRootBlockKey = "rootchain-last-block" // storage key
+	LastRootBlockKey         = "rootchain-last-block"           // storage key
+	LastTopUpFeeScanBlockKey = "rootchain-topup-fee-scan-block" // storage key of the last block scanned by the self-heal
 )
 
 // NewRootChainListener - constructor func
@@ -71,6 +76,8 @@ func (rl *RootChainListener) Start() error {
 	headerCtx, cancelHeaderProcess := context.WithCancel(context.Background())
 	rl.cancelHeaderProcess = cancelHeaderProcess
 
+	rl.reorgDetector = newReorgDetector(rl.storageClient, rl.contractConnector.MainChainClient, rootBlockHashRingSize, rl.contractConnector.MainChainTimeout)
+
 	// start header process
 	go rl.StartHeaderProcess(headerCtx)
 
@@ -122,9 +129,9 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 	}
 
 	// get last block from storage
-	hasLastBlock, _ := rl.storageClient.Has([]byte(lastRootBlockKey), nil)
+	hasLastBlock, _ := rl.storageClient.Has([]byte(LastRootBlockKey), nil)
 	if hasLastBlock {
-		lastBlockBytes, e := rl.storageClient.Get([]byte(lastRootBlockKey), nil)
+		lastBlockBytes, e := rl.storageClient.Get([]byte(LastRootBlockKey), nil)
 		if e != nil {
 			rl.Logger.Info("Error while fetching last block bytes from storage", "error", e)
 			return
@@ -149,11 +156,30 @@ func (rl *RootChainListener) ProcessHeader(newHeader *blockHeader) {
 		from = to
 	}
 
+	// Rewind if the blocks processed last are not on the canonical chain anymore
+	resumeFrom, depth, err := rl.reorgDetector.findReorg(from.Uint64())
+	if err != nil {
+		rl.Logger.Error("Error while checking for rootchain reorg", "error", err)
+		return
+	}
+
+	if depth > 0 {
+		rl.Logger.Error("Rootchain reorg detected, rewinding", "depth", depth, "fromBlock", from, "resumeFrom", resumeFrom)
+		rootChainReorgCounter.Inc()
+		rootChainReorgDepth.Set(float64(depth))
+
+		from = big.NewInt(0).SetUint64(resumeFrom)
+	}
+
 	// Set last block to storage
-	if err = rl.storageClient.Put([]byte(lastRootBlockKey), []byte(to.String()), nil); err != nil {
+	if err = rl.storageClient.Put([]byte(LastRootBlockKey), []byte(to.String()), nil); err != nil {
 		rl.Logger.Error("rl.storageClient.Put", "Error", err)
 	}
 
+	if err = rl.reorgDetector.record(from.Uint64(), to.Uint64()); err != nil {
+		rl.Logger.Error("Error while storing rootchain block hashes", "error", err)
+	}
+
 	// Handle events
 	rl.queryAndBroadcastEvents(rootchainContext, from, to)
 }
@@ -222,7 +248,7 @@ func (rl *RootChainListener) SendTaskWithDelay(taskName string, eventName string
 	signature.ETA = &eta
 	rl.Logger.Info("Sending task", "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
 
-	_, err := rl.queueConnector.Server.SendTask(signature)
+	err := rl.queueConnector.SendTask(signature)
 	if err != nil {
 		rl.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
@@ -0,0 +1,169 @@
+package listener
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	rootBlockHashPrefix = "rootchain-block-hash-" // + ring slot
+
+	// number of recent root chain blocks whose hash is kept to detect reorgs
+	rootBlockHashRingSize = 128
+)
+
+var (
+	rootChainReorgCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_reorgs",
+		Help:      "The total number of root chain reorgs detected by the listener",
+	})
+
+	rootChainReorgDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "rootchain_last_reorg_depth",
+		Help:      "Number of blocks dropped by the last root chain reorg",
+	})
+)
+
+// headerReader fetches block headers by number
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// reorgDetector keeps the hashes of the last processed root chain blocks in a ring
+// in the bridge db and finds where the listener has to resume after a reorg
+type reorgDetector struct {
+	db      *leveldb.DB
+	client  headerReader
+	size    uint64
+	timeout time.Duration
+}
+
+// newReorgDetector creates a reorg detector keeping the hashes of size blocks
+func newReorgDetector(db *leveldb.DB, client headerReader, size uint64, timeout time.Duration) *reorgDetector {
+	return &reorgDetector{
+		db:      db,
+		client:  client,
+		size:    size,
+		timeout: timeout,
+	}
+}
+
+// findReorg compares the parent hash of block from with the stored hash of its parent.
+// On mismatch it walks back the stored blocks to the latest one still on the canonical
+// chain and returns the block after it along with the number of dropped blocks.
+// If the reorg is deeper than the ring, the oldest stored block is returned.
+func (d *reorgDetector) findReorg(from uint64) (uint64, uint64, error) {
+	if from == 0 {
+		return from, 0, nil
+	}
+
+	parentHash, ok, err := d.blockHash(from - 1)
+	if err != nil || !ok {
+		return from, 0, err
+	}
+
+	header, err := d.header(from)
+	if err != nil {
+		return from, 0, err
+	}
+
+	if header.ParentHash == parentHash {
+		return from, 0, nil
+	}
+
+	for number := from - 1; ; number-- {
+		storedHash, ok, err := d.blockHash(number)
+		if err != nil {
+			return from, 0, err
+		}
+
+		// reorg is deeper than the ring
+		if !ok {
+			return number + 1, from - number - 1, nil
+		}
+
+		header, err := d.header(number)
+		if err != nil {
+			return from, 0, err
+		}
+
+		if header.Hash() == storedHash {
+			return number + 1, from - number - 1, nil
+		}
+
+		if number == 0 {
+			return 0, from, nil
+		}
+	}
+}
+
+// record stores the hashes of the last blocks of the [from, to] range
+func (d *reorgDetector) record(from uint64, to uint64) error {
+	if to >= d.size && from < to-d.size+1 {
+		from = to - d.size + 1
+	}
+
+	batch := new(leveldb.Batch)
+
+	for number := from; number <= to; number++ {
+		header, err := d.header(number)
+		if err != nil {
+			return err
+		}
+
+		value := make([]byte, 8+common.HashLength)
+		binary.BigEndian.PutUint64(value, number)
+		copy(value[8:], header.Hash().Bytes())
+
+		batch.Put(d.key(number), value)
+	}
+
+	return d.db.Write(batch, nil)
+}
+
+// blockHash returns the stored hash of the block, if it is still in the ring
+func (d *reorgDetector) blockHash(number uint64) (common.Hash, bool, error) {
+	value, err := d.db.Get(d.key(number), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return common.Hash{}, false, nil
+	} else if err != nil {
+		return common.Hash{}, false, err
+	}
+
+	// slot was overwritten by a newer block
+	if len(value) != 8+common.HashLength || binary.BigEndian.Uint64(value[:8]) != number {
+		return common.Hash{}, false, nil
+	}
+
+	return common.BytesToHash(value[8:]), true, nil
+}
+
+func (d *reorgDetector) header(number uint64) (*types.Header, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	return d.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+}
+
+func (d *reorgDetector) key(number uint64) []byte {
+	key := make([]byte, len(rootBlockHashPrefix)+8)
+	copy(key, rootBlockHashPrefix)
+	binary.BigEndian.PutUint64(key[len(rootBlockHashPrefix):], number%d.size)
+
+	return key
+}
@@ -0,0 +1,121 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func newReorgTestDetector(t *testing.T, size uint64) (*reorgDetector, *simulated.Backend) {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	backend := simulated.NewBackend(types.GenesisAlloc{})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	return newReorgDetector(db, backend.Client(), size, 5*time.Second), backend
+}
+
+// commitBlocks mines n blocks
+func commitBlocks(t *testing.T, backend *simulated.Backend, n int) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		backend.Commit()
+	}
+}
+
+// reorgAt replaces the blocks after number with a longer chain
+func reorgAt(t *testing.T, backend *simulated.Backend, number uint64, length int) {
+	t.Helper()
+
+	parent, err := backend.Client().HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+	require.NoError(t, err)
+
+	require.NoError(t, backend.Fork(parent.Hash()))
+
+	// a different timestamp gives the new blocks a different hash
+	require.NoError(t, backend.AdjustTime(time.Minute))
+	commitBlocks(t, backend, length-1)
+}
+
+func TestReorgDetectorNoReorg(t *testing.T) {
+	t.Parallel()
+
+	detector, backend := newReorgTestDetector(t, rootBlockHashRingSize)
+
+	commitBlocks(t, backend, 10)
+	require.NoError(t, detector.record(1, 10))
+
+	commitBlocks(t, backend, 5)
+
+	resumeFrom, depth, err := detector.findReorg(11)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), resumeFrom)
+	require.Zero(t, depth)
+}
+
+func TestReorgDetectorNothingRecorded(t *testing.T) {
+	t.Parallel()
+
+	detector, backend := newReorgTestDetector(t, rootBlockHashRingSize)
+
+	commitBlocks(t, backend, 10)
+
+	resumeFrom, depth, err := detector.findReorg(5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resumeFrom)
+	require.Zero(t, depth)
+}
+
+func TestReorgDetectorRewind(t *testing.T) {
+	t.Parallel()
+
+	detector, backend := newReorgTestDetector(t, rootBlockHashRingSize)
+
+	commitBlocks(t, backend, 10)
+	require.NoError(t, detector.record(1, 10))
+
+	// blocks 6 to 10 are replaced
+	reorgAt(t, backend, 5, 8)
+
+	resumeFrom, depth, err := detector.findReorg(11)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), resumeFrom)
+	require.Equal(t, uint64(5), depth)
+
+	// once the new blocks are recorded there is nothing to rewind
+	require.NoError(t, detector.record(resumeFrom, 12))
+
+	resumeFrom, depth, err = detector.findReorg(13)
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), resumeFrom)
+	require.Zero(t, depth)
+}
+
+func TestReorgDetectorDeeperThanRing(t *testing.T) {
+	t.Parallel()
+
+	detector, backend := newReorgTestDetector(t, 3)
+
+	commitBlocks(t, backend, 10)
+	require.NoError(t, detector.record(1, 10))
+
+	// only blocks 8 to 10 are kept, the reorg replaces blocks from 4
+	reorgAt(t, backend, 3, 10)
+
+	resumeFrom, depth, err := detector.findReorg(11)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), resumeFrom)
+	require.Equal(t, uint64(3), depth)
+}
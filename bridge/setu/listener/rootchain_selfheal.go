@@ -3,15 +3,20 @@ package listener
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
+
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/client/restclient"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
@@ -29,34 +34,73 @@ var (
 		Name:      "StakeUpdate",
 		Help:      "The total number of missing StakeUpdate events",
 	}, []string{"id", "nonce", "contract_address", "block_number", "tx_hash"})
+
+	topUpFeeCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "self_healing",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "TopUpFee",
+		Help:      "The total number of missing TopUpFee events",
+	}, []string{"contract_address", "block_number", "tx_hash"})
 )
 
-type subGraphClient struct {
-	graphUrl   string
-	httpClient *http.Client
+// selfHealSource looks up L1 events which might be missing on heimdall
+type selfHealSource interface {
+	// getLatestStateID returns the latest state id sent on L1
+	getLatestStateID(ctx context.Context) (*big.Int, error)
+
+	// getStateSync returns the StateSynced log for the given state id
+	getStateSync(ctx context.Context, stateId int64) (*types.Log, error)
+
+	// getLatestNonce returns the latest validator nonce on L1
+	getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error)
+
+	// getStakeUpdate returns the staking log which moved the validator to the given nonce
+	getStakeUpdate(ctx context.Context, validatorId, nonce uint64) (*types.Log, error)
+}
+
+// topUpFeeSource is implemented by self-heal sources able to list recent TopUpFee events
+type topUpFeeSource interface {
+	// getTopUpFees returns the TopUpFee logs of the blocks after lastBlock (all the recent
+	// blocks without lastBlock), and the last block scanned
+	getTopUpFees(ctx context.Context, lastBlock uint64, hasLastBlock bool) ([]*types.Log, uint64, error)
 }
 
 // startSelfHealing starts self-healing processes for all required events
 func (rl *RootChainListener) startSelfHealing(ctx context.Context) {
-	if !helper.GetConfig().EnableSH || helper.GetConfig().SubGraphUrl == "" {
+	if !helper.GetConfig().EnableSH {
 		rl.Logger.Info("Self-healing disabled")
 		return
 	}
 
-	rl.subGraphClient = &subGraphClient{
-		graphUrl:   helper.GetConfig().SubGraphUrl,
-		httpClient: &http.Client{Timeout: 5 * time.Second},
+	source := "log scan"
+
+	if helper.GetConfig().SubGraphUrl != "" {
+		source = "sub graph"
+		rl.selfHealSource = &subGraphClient{
+			graphUrl:        helper.GetConfig().SubGraphUrl,
+			httpClient:      &http.Client{Timeout: 5 * time.Second},
+			mainChainClient: rl.contractConnector.MainChainClient,
+		}
+	} else {
+		logScanClient, err := newLogScanClient(rl)
+		if err != nil {
+			rl.Logger.Error("Unable to create self-healing log scan client", "error", err)
+			return
+		}
+
+		rl.selfHealSource = logScanClient
 	}
 
 	stakeUpdateTicker := time.NewTicker(helper.GetConfig().SHStakeUpdateInterval)
 	stateSyncedTicker := time.NewTicker(helper.GetConfig().SHStateSyncedInterval)
 
-	rl.Logger.Info("Started self-healing")
+	rl.Logger.Info("Started self-healing", "source", source)
 
 	for {
 		select {
 		case <-stakeUpdateTicker.C:
 			rl.processStakeUpdate(ctx)
+			rl.processTopUpFee(ctx)
 		case <-stateSyncedTicker.C:
 			rl.processStateSynced(ctx)
 		case <-ctx.Done():
@@ -97,7 +141,7 @@ func (rl *RootChainListener) processStakeUpdate(ctx context.Context) {
 			var ethereumNonce uint64
 
 			if err = helper.ExponentialBackoff(func() error {
-				ethereumNonce, err = rl.getLatestNonce(ctx, id)
+				ethereumNonce, err = rl.selfHealSource.getLatestNonce(ctx, id)
 				return err
 			}, 3, time.Second); err != nil {
 				rl.Logger.Error("Error getting nonce for validator from L1", "error", err, "id", id)
@@ -115,7 +159,7 @@ func (rl *RootChainListener) processStakeUpdate(ctx context.Context) {
 			var stakeUpdate *types.Log
 
 			if err = helper.ExponentialBackoff(func() error {
-				stakeUpdate, err = rl.getStakeUpdate(ctx, id, nonce)
+				stakeUpdate, err = rl.selfHealSource.getStakeUpdate(ctx, id, nonce)
 				return err
 			}, 3, time.Second); err != nil {
 				rl.Logger.Error("Error getting stake update for validator", "error", err, "id", id)
@@ -149,7 +193,7 @@ func (rl *RootChainListener) processStateSynced(ctx context.Context) {
 		return
 	}
 
-	latestEthereumStateId, err := rl.getLatestStateID(ctx)
+	latestEthereumStateId, err := rl.selfHealSource.getLatestStateID(ctx)
 	if err != nil {
 		rl.Logger.Error("Unable to fetch latest state id from state sender contract", "error", err)
 		return
@@ -170,7 +214,7 @@ func (rl *RootChainListener) processStateSynced(ctx context.Context) {
 		var stateSynced *types.Log
 
 		if err = helper.ExponentialBackoff(func() error {
-			stateSynced, err = rl.getStateSync(ctx, i)
+			stateSynced, err = rl.selfHealSource.getStateSync(ctx, i)
 			return err
 		}, 3, time.Second); err != nil {
 			rl.Logger.Error("Error getting state sync", "error", err, "id", i)
@@ -214,6 +258,125 @@ func (rl *RootChainListener) processStateSynced(ctx context.Context) {
 	}
 }
 
+// processTopUpFee broadcasts TopUpFee events missing on heimdall, if the self-heal source can list them
+func (rl *RootChainListener) processTopUpFee(ctx context.Context) {
+	source, ok := rl.selfHealSource.(topUpFeeSource)
+	if !ok {
+		return
+	}
+
+	lastBlock, hasLastBlock := rl.getLastTopUpFeeScanBlock()
+
+	topUpFees, scannedBlock, err := source.getTopUpFees(ctx, lastBlock, hasLastBlock)
+	if err != nil {
+		rl.Logger.Error("Error getting top up fees from L1", "error", err)
+		return
+	}
+
+	// the next scan starts after the scanned blocks, or at the first top up fee left to process
+	retryTopUpFee := func(topUpFee *types.Log) {
+		if topUpFee.BlockNumber > 0 && topUpFee.BlockNumber <= scannedBlock {
+			scannedBlock = topUpFee.BlockNumber - 1
+		}
+	}
+
+	defer func() {
+		if !hasLastBlock || scannedBlock > lastBlock {
+			rl.setLastTopUpFeeScanBlock(scannedBlock)
+		}
+	}()
+
+	for _, topUpFee := range topUpFees {
+		isOld, err := rl.isOldTopUpFee(topUpFee)
+		if err != nil {
+			rl.Logger.Error("Error checking top up fee on heimdall", "error", err, "txHash", topUpFee.TxHash.Hex())
+			retryTopUpFee(topUpFee)
+
+			continue
+		}
+
+		if isOld {
+			continue
+		}
+
+		rl.Logger.Info("Processing top up fee", "txHash", topUpFee.TxHash.Hex(), "logIndex", topUpFee.Index)
+
+		ignore, err := rl.processEvent(ctx, topUpFee)
+		if err != nil {
+			rl.Logger.Error("Error processing top up fee", "error", err, "txHash", topUpFee.TxHash.Hex())
+			retryTopUpFee(topUpFee)
+
+			continue
+		}
+
+		// too recent to be missing on heimdall, checked again on the next scan
+		if ignore {
+			retryTopUpFee(topUpFee)
+			continue
+		}
+
+		topUpFeeCounter.WithLabelValues(
+			topUpFee.Address.Hex(),
+			fmt.Sprintf("%d", topUpFee.BlockNumber),
+			topUpFee.TxHash.Hex(),
+		).Add(1)
+	}
+}
+
+// getLastTopUpFeeScanBlock returns the last L1 block scanned for TopUpFee events from bridge storage
+func (rl *RootChainListener) getLastTopUpFeeScanBlock() (uint64, bool) {
+	lastBlockBytes, err := rl.storageClient.Get([]byte(LastTopUpFeeScanBlockKey), nil)
+	if err != nil {
+		return 0, false
+	}
+
+	lastBlock, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return lastBlock, true
+}
+
+// setLastTopUpFeeScanBlock stores the last L1 block scanned for TopUpFee events in bridge storage
+func (rl *RootChainListener) setLastTopUpFeeScanBlock(block uint64) {
+	if err := rl.storageClient.Put([]byte(LastTopUpFeeScanBlockKey), []byte(strconv.FormatUint(block, 10)), nil); err != nil {
+		rl.Logger.Error("rl.storageClient.Put", "Error", err)
+	}
+}
+
+// isOldTopUpFee checks if the top up fee was already processed by heimdall
+func (rl *RootChainListener) isOldTopUpFee(vLog *types.Log) (bool, error) {
+	status, _, err := restclient.New(rl.cliCtx).IsOldTopupTx(vLog.TxHash.Hex(), uint64(vLog.Index))
+	if err != nil {
+		return false, err
+	}
+
+	return status, nil
+}
+
+// getCurrentStateID returns the current state ID handled by the polygon chain
+func (rl *RootChainListener) getCurrentStateID(ctx context.Context) (*big.Int, error) {
+	rootchainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	stateReceiverInstance, err := rl.contractConnector.GetStateReceiverInstance(
+		rootchainContext.ChainmanagerParams.ChainParams.StateReceiverAddress.EthAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stateId, err := stateReceiverInstance.LastStateId(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return stateId, nil
+}
+
 func (rl *RootChainListener) processEvent(ctx context.Context, vLog *types.Log) (bool, error) {
 	blockTime, err := rl.contractConnector.GetMainChainBlockTime(ctx, vLog.BlockNumber)
 	if err != nil {
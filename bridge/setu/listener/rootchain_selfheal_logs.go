@@ -0,0 +1,396 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// number of logs kept per cache by the log scan source
+const logScanCacheSize = 10000
+
+// errLogNotFound is returned when the log is not in the scanned block range
+var errLogNotFound = errors.New("log not found in scanned L1 blocks")
+
+// staking events carrying a validator nonce
+var stakingNonceEvents = []string{"Staked", "StakeUpdate", "UnstakeInit", "SignerChange"}
+
+// logScanClient is the self-heal source walking L1 logs with eth_getLogs,
+// used when no sub graph is configured
+type logScanClient struct {
+	rl *RootChainListener
+
+	// blocks per eth_getLogs request and max blocks scanned back from the finalized head
+	window    uint64
+	maxBlocks uint64
+
+	// logs seen while scanning, by state id and by validator nonce
+	stateSyncs  *lru.Cache
+	stakingLogs *lru.Cache
+}
+
+type validatorNonce struct {
+	validatorId uint64
+	nonce       uint64
+}
+
+// blockRange is an inclusive range of L1 blocks
+type blockRange struct {
+	from uint64
+	to   uint64
+}
+
+// newLogScanClient creates log scan source for the root chain listener
+func newLogScanClient(rl *RootChainListener) (*logScanClient, error) {
+	stateSyncs, err := lru.New(logScanCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingLogs, err := lru.New(logScanCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logScanClient{
+		rl:          rl,
+		window:      helper.GetConfig().SHLogScanWindow,
+		maxBlocks:   helper.GetConfig().SHLogScanMaxBlocks,
+		stateSyncs:  stateSyncs,
+		stakingLogs: stakingLogs,
+	}, nil
+}
+
+// getLatestStateID returns state counter of the state sender contract at the finalized block
+func (lc *logScanClient) getLatestStateID(ctx context.Context) (*big.Int, error) {
+	rootchainContext, err := lc.rl.getRootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	stateSenderInstance, err := lc.rl.contractConnector.GetStateSenderInstance(
+		rootchainContext.ChainmanagerParams.ChainParams.StateSenderAddress.EthAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := lc.finalizedBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return stateSenderInstance.Counter(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)})
+}
+
+// getStateSync returns the StateSynced log for the given state id
+func (lc *logScanClient) getStateSync(ctx context.Context, stateId int64) (*types.Log, error) {
+	//nolint:gosec
+	id := uint64(stateId)
+
+	if vLog, ok := lc.stateSyncs.Get(id); ok {
+		return vLog.(*types.Log), nil
+	}
+
+	rootchainContext, err := lc.rl.getRootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{rootchainContext.ChainmanagerParams.ChainParams.StateSenderAddress.EthAddress()},
+		Topics:    [][]common.Hash{{lc.rl.stateSenderAbi.Events["StateSynced"].ID}},
+	}
+
+	var stateSynced *types.Log
+
+	// cache every state sync of the scanned windows, missing state ids are usually consecutive
+	if err = lc.scanLogs(ctx, query, func(vLog *types.Log) bool {
+		if len(vLog.Topics) < 2 {
+			return false
+		}
+
+		logId := new(big.Int).SetBytes(vLog.Topics[1].Bytes()).Uint64()
+		lc.stateSyncs.Add(logId, vLog)
+
+		if logId != id {
+			return false
+		}
+
+		stateSynced = vLog
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("state sync %d: %w", stateId, err)
+	}
+
+	return stateSynced, nil
+}
+
+// getLatestNonce returns validator nonce from the staking info contract at the finalized block
+func (lc *logScanClient) getLatestNonce(ctx context.Context, validatorId uint64) (uint64, error) {
+	rootchainContext, err := lc.rl.getRootChainContext()
+	if err != nil {
+		return 0, err
+	}
+
+	stakingInfoInstance, err := lc.rl.contractConnector.GetStakingInfoInstance(
+		rootchainContext.ChainmanagerParams.ChainParams.StakingInfoAddress.EthAddress(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	head, err := lc.finalizedBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := stakingInfoInstance.ValidatorNonce(
+		&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)},
+		new(big.Int).SetUint64(validatorId),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return nonce.Uint64(), nil
+}
+
+// getStakeUpdate returns the staking log (Staked, StakeUpdate, UnstakeInit or SignerChange)
+// which moved the validator to the given nonce
+func (lc *logScanClient) getStakeUpdate(ctx context.Context, validatorId, nonce uint64) (*types.Log, error) {
+	key := validatorNonce{validatorId: validatorId, nonce: nonce}
+
+	if vLog, ok := lc.stakingLogs.Get(key); ok {
+		return vLog.(*types.Log), nil
+	}
+
+	rootchainContext, err := lc.rl.getRootChainContext()
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]common.Hash, 0, len(stakingNonceEvents))
+	for _, name := range stakingNonceEvents {
+		topics = append(topics, lc.rl.stakingInfoAbi.Events[name].ID)
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{rootchainContext.ChainmanagerParams.ChainParams.StakingInfoAddress.EthAddress()},
+		Topics:    [][]common.Hash{topics},
+	}
+
+	var stakingLog *types.Log
+
+	if err = lc.scanLogs(ctx, query, func(vLog *types.Log) bool {
+		logKey, err := lc.decodeValidatorNonce(vLog)
+		if err != nil {
+			lc.rl.Logger.Error("Error decoding staking log", "txHash", vLog.TxHash, "logIndex", vLog.Index, "error", err)
+			return false
+		}
+
+		lc.stakingLogs.Add(logKey, vLog)
+
+		if logKey != key {
+			return false
+		}
+
+		stakingLog = vLog
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("validator %d nonce %d: %w", validatorId, nonce, err)
+	}
+
+	return stakingLog, nil
+}
+
+// getTopUpFees returns the TopUpFee logs of the blocks after lastBlock up to the finalized head,
+// at most maxBlocks of them, and the finalized head
+func (lc *logScanClient) getTopUpFees(ctx context.Context, lastBlock uint64, hasLastBlock bool) ([]*types.Log, uint64, error) {
+	rootchainContext, err := lc.rl.getRootChainContext()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	head, err := lc.finalizedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blocks := topUpFeeScanBlocks(head, lc.maxBlocks, lastBlock, hasLastBlock)
+	if blocks == 0 {
+		return nil, lastBlock, nil
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{rootchainContext.ChainmanagerParams.ChainParams.StakingInfoAddress.EthAddress()},
+		Topics:    [][]common.Hash{{lc.rl.stakingInfoAbi.Events["TopUpFee"].ID}},
+	}
+
+	var topUpFees []*types.Log
+
+	if err = lc.scanBlocks(ctx, query, head, blocks, func(vLog *types.Log) bool {
+		topUpFees = append(topUpFees, vLog)
+		return false
+	}); err != nil && !errors.Is(err, errLogNotFound) {
+		return nil, 0, err
+	}
+
+	return topUpFees, head, nil
+}
+
+// topUpFeeScanBlocks returns the number of blocks to scan for TopUpFee events back from head,
+// the blocks after lastBlock and at most maxBlocks
+func topUpFeeScanBlocks(head, maxBlocks, lastBlock uint64, hasLastBlock bool) uint64 {
+	if !hasLastBlock {
+		return maxBlocks
+	}
+
+	if lastBlock >= head {
+		return 0
+	}
+
+	if head-lastBlock < maxBlocks {
+		return head - lastBlock
+	}
+
+	return maxBlocks
+}
+
+// decodeValidatorNonce returns validator id and nonce of a staking log
+func (lc *logScanClient) decodeValidatorNonce(vLog *types.Log) (validatorNonce, error) {
+	selectedEvent := helper.EventByID(lc.rl.stakingInfoAbi, vLog.Topics[0].Bytes())
+	if selectedEvent == nil {
+		return validatorNonce{}, errors.New("unknown staking event")
+	}
+
+	var validatorId, nonce *big.Int
+
+	switch selectedEvent.Name {
+	case "Staked":
+		event := new(stakinginfo.StakinginfoStaked)
+		if err := helper.UnpackLog(lc.rl.stakingInfoAbi, event, selectedEvent.Name, vLog); err != nil {
+			return validatorNonce{}, err
+		}
+
+		validatorId, nonce = event.ValidatorId, event.Nonce
+	case "StakeUpdate":
+		event := new(stakinginfo.StakinginfoStakeUpdate)
+		if err := helper.UnpackLog(lc.rl.stakingInfoAbi, event, selectedEvent.Name, vLog); err != nil {
+			return validatorNonce{}, err
+		}
+
+		validatorId, nonce = event.ValidatorId, event.Nonce
+	case "UnstakeInit":
+		event := new(stakinginfo.StakinginfoUnstakeInit)
+		if err := helper.UnpackLog(lc.rl.stakingInfoAbi, event, selectedEvent.Name, vLog); err != nil {
+			return validatorNonce{}, err
+		}
+
+		validatorId, nonce = event.ValidatorId, event.Nonce
+	case "SignerChange":
+		event := new(stakinginfo.StakinginfoSignerChange)
+		if err := helper.UnpackLog(lc.rl.stakingInfoAbi, event, selectedEvent.Name, vLog); err != nil {
+			return validatorNonce{}, err
+		}
+
+		validatorId, nonce = event.ValidatorId, event.Nonce
+	default:
+		return validatorNonce{}, fmt.Errorf("unexpected staking event %s", selectedEvent.Name)
+	}
+
+	return validatorNonce{validatorId: validatorId.Uint64(), nonce: nonce.Uint64()}, nil
+}
+
+// scanLogs walks back from the finalized head one window at a time, passing every log
+// of a window to visit, and stops after the window in which visit returned true
+func (lc *logScanClient) scanLogs(ctx context.Context, query ethereum.FilterQuery, visit func(vLog *types.Log) bool) error {
+	head, err := lc.finalizedBlock()
+	if err != nil {
+		return err
+	}
+
+	return lc.scanBlocks(ctx, query, head, lc.maxBlocks, visit)
+}
+
+// scanBlocks walks back the last blocks up to head like scanLogs
+func (lc *logScanClient) scanBlocks(ctx context.Context, query ethereum.FilterQuery, head, blocks uint64, visit func(vLog *types.Log) bool) error {
+	for _, window := range logScanWindows(head, lc.window, blocks) {
+		query.FromBlock = new(big.Int).SetUint64(window.from)
+		query.ToBlock = new(big.Int).SetUint64(window.to)
+
+		logs, err := lc.rl.contractConnector.MainChainClient.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		found := false
+
+		for i := range logs {
+			if logs[i].Removed {
+				continue
+			}
+
+			if visit(&logs[i]) {
+				found = true
+			}
+		}
+
+		if found {
+			return nil
+		}
+	}
+
+	return errLogNotFound
+}
+
+// finalizedBlock returns the finalized L1 block number
+func (lc *logScanClient) finalizedBlock() (uint64, error) {
+	header, err := lc.rl.contractConnector.GetMainChainFinalizedBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// logScanWindows splits the last maxBlocks blocks up to head in windows of the given size, newest first
+func logScanWindows(head, window, maxBlocks uint64) []blockRange {
+	if window == 0 || maxBlocks == 0 {
+		return nil
+	}
+
+	var lowest uint64
+	if head >= maxBlocks {
+		lowest = head - maxBlocks + 1
+	}
+
+	windows := make([]blockRange, 0, (head-lowest)/window+1)
+
+	for to := head; ; {
+		from := lowest
+		if to-lowest >= window {
+			from = to - window + 1
+		}
+
+		windows = append(windows, blockRange{from: from, to: to})
+
+		if from == lowest {
+			return windows
+		}
+
+		to = from - 1
+	}
+}
@@ -0,0 +1,118 @@
+package listener
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogScanWindows(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		head      uint64
+		window    uint64
+		maxBlocks uint64
+		expected  []blockRange
+	}{
+		{
+			name:      "scan down to genesis",
+			head:      2500,
+			window:    1000,
+			maxBlocks: 100000,
+			expected:  []blockRange{{1501, 2500}, {501, 1500}, {0, 500}},
+		},
+		{
+			name:      "scan limited by max blocks",
+			head:      2500,
+			window:    1000,
+			maxBlocks: 1500,
+			expected:  []blockRange{{1501, 2500}, {1001, 1500}},
+		},
+		{
+			name:      "window larger than max blocks",
+			head:      2500,
+			window:    1000,
+			maxBlocks: 10,
+			expected:  []blockRange{{2491, 2500}},
+		},
+		{
+			name:      "exact windows",
+			head:      1999,
+			window:    1000,
+			maxBlocks: 2000,
+			expected:  []blockRange{{1000, 1999}, {0, 999}},
+		},
+		{
+			name:      "empty window",
+			head:      2500,
+			window:    0,
+			maxBlocks: 1000,
+			expected:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, logScanWindows(tc.head, tc.window, tc.maxBlocks))
+		})
+	}
+}
+
+func TestTopUpFeeScanBlocks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		head         uint64
+		maxBlocks    uint64
+		lastBlock    uint64
+		hasLastBlock bool
+		expected     uint64
+	}{
+		{
+			name:      "first scan",
+			head:      2500,
+			maxBlocks: 1000,
+			expected:  1000,
+		},
+		{
+			name:         "blocks after the last scanned block",
+			head:         2500,
+			maxBlocks:    1000,
+			lastBlock:    2200,
+			hasLastBlock: true,
+			expected:     300,
+		},
+		{
+			name:         "last scanned block older than max blocks",
+			head:         2500,
+			maxBlocks:    1000,
+			lastBlock:    100,
+			hasLastBlock: true,
+			expected:     1000,
+		},
+		{
+			name:         "no new block",
+			head:         2500,
+			maxBlocks:    1000,
+			lastBlock:    2500,
+			hasLastBlock: true,
+			expected:     0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, topUpFeeScanBlocks(tc.head, tc.maxBlocks, tc.lastBlock, tc.hasLastBlock))
+		})
+	}
+
+	// the windows of the blocks to scan start after the last scanned block
+	require.Equal(t, []blockRange{{2401, 2500}, {2301, 2400}, {2201, 2300}}, logScanWindows(2500, 100, topUpFeeScanBlocks(2500, 1000, 2200, true)))
+}
@@ -27,7 +27,7 @@ type ListenerService struct {
 }
 
 // NewListenerService returns new service object for listneing to events
-func NewListenerService(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP) *ListenerService {
+func NewListenerService(cdc *codec.Codec, queueConnector queue.TaskQueue, httpClient *httpClient.HTTP) *ListenerService {
 	var logger = util.Logger().With("service", ListenerServiceStr)
 
 	// creating listener object
@@ -2,6 +2,7 @@ package processor
 
 import (
 	"encoding/base64"
+	"fmt"
 	"io"
 	"net/http"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/client/restclient"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
@@ -40,7 +42,7 @@ type BaseProcessor struct {
 	quit   chan struct{}
 
 	// queue connector
-	queueConnector *queue.QueueConnector
+	queueConnector queue.TaskQueue
 
 	// tx broadcaster
 	txBroadcaster *broadcaster.TxBroadcaster
@@ -59,10 +61,13 @@ type BaseProcessor struct {
 
 	// storage client
 	storageClient *leveldb.DB
+
+	// tracker of the inclusion of the event transactions, shared by the processors
+	inclusionTracker *inclusionTracker
 }
 
 // NewBaseProcessor creates a new BaseProcessor.
-func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, txBroadcaster *broadcaster.TxBroadcaster, name string, impl Processor) *BaseProcessor {
+func NewBaseProcessor(cdc *codec.Codec, queueConnector queue.TaskQueue, httpClient *httpClient.HTTP, txBroadcaster *broadcaster.TxBroadcaster, name string, impl Processor) *BaseProcessor {
 	logger := util.Logger().With("service", "processor", "module", name)
 
 	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
@@ -87,7 +92,7 @@ func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, ht
 		impl:   impl,
 
 		cliCtx:            cliCtx,
-		queueConnector:    queueConnector,
+		queueConnector:    trackTaskHealth(queueConnector, name),
 		contractConnector: contractCaller,
 		txBroadcaster:     txBroadcaster,
 		httpClient:        httpClient,
@@ -110,40 +115,29 @@ func (bp *BaseProcessor) Stop() {
 func (bp *BaseProcessor) isOldTx(_ cliContext.CLIContext, txHash string, logIndex uint64, eventType util.BridgeEvent, event interface{}) (bool, error) {
 	defer util.LogElapsedTimeForStateSyncedEvent(event, "isOldTx", time.Now())
 
-	queryParam := map[string]interface{}{
-		"txhash":   txHash,
-		"logindex": logIndex,
-	}
+	client := restclient.New(bp.cliCtx)
 
-	// define the endpoint based on the type of event
-	var endpoint string
+	// query the tx status of the module of the event
+	var (
+		status bool
+		err    error
+	)
 
 	switch eventType {
 	case util.StakingEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.StakingTxStatusURL)
+		status, _, err = client.IsOldStakingTx(txHash, logIndex)
 	case util.TopupEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.TopupTxStatusURL)
+		status, _, err = client.IsOldTopupTx(txHash, logIndex)
 	case util.ClerkEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.ClerkTxStatusURL)
+		status, _, err = client.IsOldClerkTx(txHash, logIndex)
 	case util.SlashingEvent:
-		endpoint = helper.GetHeimdallServerEndpoint(util.SlashingTxStatusURL)
+		status, _, err = client.IsOldSlashingTx(txHash, logIndex)
+	default:
+		err = fmt.Errorf("unknown bridge event type %s", eventType)
 	}
 
-	url, err := util.CreateURLWithQuery(endpoint, queryParam)
 	if err != nil {
-		bp.Logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
-		return false, err
-	}
-
-	res, err := helper.FetchFromAPI(bp.cliCtx, url)
-	if err != nil {
-		bp.Logger.Error("Error fetching tx status", "url", url, "error", err)
-		return false, err
-	}
-
-	var status bool
-	if err := jsoniter.ConfigFastest.Unmarshal(res.Result, &status); err != nil {
-		bp.Logger.Error("Error unmarshalling tx status received from Heimdall Server", "error", err)
+		bp.Logger.Error("Error fetching tx status", "eventType", eventType, "txHash", txHash, "error", err)
 		return false, err
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/client/restclient"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -39,11 +40,6 @@ type CheckpointProcessor struct {
 	rootchainAbi *abi.ABI
 }
 
-// Result represents single req result
-type Result struct {
-	Result uint64 `json:"result"`
-}
-
 // CheckpointContext represents checkpoint context
 type CheckpointContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
@@ -74,15 +70,15 @@ func (cp *CheckpointProcessor) Start() error {
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
 
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
 }
@@ -313,6 +309,8 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			return fmt.Errorf("checkpoint-ack tx failed, tx response code: %d", txRes.Code)
 
 		}
+
+		cp.trackL1EventInclusion(eventName, log.BlockNumber, txRes)
 	}
 
 	return nil
@@ -551,17 +549,20 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 		chainParams := checkpointContext.ChainmanagerParams.ChainParams
 		// root chain address
 		rootChainAddress := chainParams.RootChainAddress.EthAddress()
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
+
+		data, err := cp.contractConnector.RootChainABI.Pack("submitCheckpoint", sideTxData, sigs)
 		if err != nil {
-			cp.Logger.Info("Error while creating rootchain instance", "error", err)
+			cp.Logger.Error("Unable to pack tx for submitCheckpoint", "error", err)
 			return err
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance); err != nil {
+		rootTxHash, err := cp.txBroadcaster.BroadcastToRootchain(rootChainAddress, data, fmt.Sprintf("checkpoint %d-%d", start, end))
+		if err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
+
+		cp.Logger.Info("Submitted new checkpoint to rootchain", "start", start, "end", end, "txHash", rootTxHash.Hex())
 	}
 
 	return nil
@@ -571,7 +572,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTypes.HeimdallHash, err error) {
 	cp.Logger.Info("Sending Rest call to Get Dividend AccountRootHash")
 
-	response, err := helper.FetchFromAPI(cp.cliCtx, helper.GetHeimdallServerEndpoint(util.DividendAccountRootURL))
+	accountroothash, _, err = restclient.New(cp.cliCtx).GetDividendAccountRoot()
 	if err != nil {
 		cp.Logger.Error("Error Fetching accountroothash from HeimdallServer ", "error", err)
 		return accountroothash, err
@@ -579,11 +580,6 @@ func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTyp
 
 	cp.Logger.Info("Divident account root fetched")
 
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &accountroothash); err != nil {
-		cp.Logger.Error("Error unmarshalling accountroothash received from Heimdall Server", "error", err)
-		return accountroothash, err
-	}
-
 	return accountroothash, nil
 }
 
@@ -618,18 +614,12 @@ func (cp *CheckpointProcessor) getLatestCheckpointTime(checkpointContext *Checkp
 }
 
 func (cp *CheckpointProcessor) getLastNoAckTime() uint64 {
-	response, err := helper.FetchFromAPI(cp.cliCtx, helper.GetHeimdallServerEndpoint(util.LastNoAckURL))
+	noAckObject, _, err := restclient.New(cp.cliCtx).GetLastNoAck()
 	if err != nil {
 		cp.Logger.Error("Error while sending request for last no-ack", "Error", err)
 		return 0
 	}
 
-	var noAckObject Result
-	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &noAckObject); err != nil {
-		cp.Logger.Error("Error unmarshalling no-ack data ", "error", err)
-		return 0
-	}
-
 	return noAckObject.Result
 }
 
@@ -50,7 +50,7 @@ func (cp *ClerkProcessor) Start() error {
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
 
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
 }
@@ -157,13 +157,15 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 
 		_, BroadcastToHeimdallSpan := tracing.StartSpan(sendStateSyncedToHeimdallCtx, "BroadcastToHeimdall")
 		// return broadcast to heimdall
-		_, err = cp.txBroadcaster.BroadcastToHeimdall(msg, event)
+		txRes, err := cp.txBroadcaster.BroadcastToHeimdall(msg, event)
 		tracing.EndSpan(BroadcastToHeimdallSpan)
 
 		if err != nil {
 			cp.Logger.Error("Error while broadcasting clerk Record to heimdall", "error", err)
 			return err
 		}
+
+		cp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
@@ -0,0 +1,148 @@
+package processor
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+)
+
+// Health is the outcome of the latest tasks run by a processor
+type Health struct {
+	Succeeded uint64 `json:"succeeded"`
+	Failed    uint64 `json:"failed"`
+	InFlight  int    `json:"inFlight"`
+
+	LastSuccess     time.Time `json:"lastSuccess,omitempty"`
+	LastSuccessTask string    `json:"lastSuccessTask,omitempty"`
+	LastFailure     time.Time `json:"lastFailure,omitempty"`
+	LastFailureTask string    `json:"lastFailureTask,omitempty"`
+	LastError       string    `json:"lastError,omitempty"`
+}
+
+var (
+	healthMu sync.Mutex
+	health   = make(map[string]*Health)
+)
+
+// ProcessorsHealth returns the health of every processor which registered tasks, by processor name
+func ProcessorsHealth() map[string]Health {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+
+	result := make(map[string]Health, len(health))
+	for name, h := range health {
+		result[name] = *h
+	}
+
+	return result
+}
+
+// taskStarted records a task of processor being run
+func taskStarted(processor string) {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+
+	processorHealth(processor).InFlight++
+}
+
+// taskFinished records the outcome of a task of processor
+func taskFinished(processor string, taskName string, err error) {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+
+	h := processorHealth(processor)
+	h.InFlight--
+
+	if err == nil {
+		h.Succeeded++
+		h.LastSuccess = time.Now()
+		h.LastSuccessTask = taskName
+
+		return
+	}
+
+	h.Failed++
+	h.LastFailure = time.Now()
+	h.LastFailureTask = taskName
+	h.LastError = err.Error()
+}
+
+// processorHealth returns the health record of processor, healthMu must be held
+func processorHealth(processor string) *Health {
+	h, ok := health[processor]
+	if !ok {
+		h = &Health{}
+		health[processor] = h
+	}
+
+	return h
+}
+
+// healthTrackingQueue records the outcome of the tasks registered by a processor, in its health and metrics
+type healthTrackingQueue struct {
+	queue.TaskQueue
+
+	processor string
+}
+
+// trackTaskHealth wraps taskQueue so that the tasks registered through it are tracked for processor
+func trackTaskHealth(taskQueue queue.TaskQueue, processor string) queue.TaskQueue {
+	if taskQueue == nil {
+		return nil
+	}
+
+	return &healthTrackingQueue{TaskQueue: taskQueue, processor: processor}
+}
+
+// RegisterTask registers taskFunc wrapped in a function of the same signature recording its outcome
+func (q *healthTrackingQueue) RegisterTask(name string, taskFunc interface{}) error {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return err
+	}
+
+	fn := reflect.ValueOf(taskFunc)
+
+	wrapped := reflect.MakeFunc(fn.Type(), func(args []reflect.Value) (results []reflect.Value) {
+		_ = runTracked(q.processor, name, func() error {
+			results = fn.Call(args)
+
+			// machinery tasks return an error as last value
+			err, _ := results[len(results)-1].Interface().(error)
+
+			return err
+		})
+
+		return results
+	})
+
+	return q.TaskQueue.RegisterTask(name, wrapped.Interface())
+}
+
+// runTracked runs task of processor and records its outcome in the processor health and metrics
+func runTracked(processor string, task string, run func() error) error {
+	taskStarted(processor)
+	tasksReceived.WithLabelValues(processor, task).Inc()
+	tasksInFlight.WithLabelValues(processor).Inc()
+
+	start := time.Now()
+	err := run()
+
+	taskFinished(processor, task, err)
+	tasksInFlight.WithLabelValues(processor).Dec()
+	taskDuration.WithLabelValues(processor, task).Observe(time.Since(start).Seconds())
+
+	switch err.(type) {
+	case nil:
+		tasksSucceeded.WithLabelValues(processor, task).Inc()
+	case tasks.ErrRetryTaskLater:
+		tasksRetried.WithLabelValues(processor, task).Inc()
+	default:
+		tasksFailed.WithLabelValues(processor, task).Inc()
+	}
+
+	return err
+}
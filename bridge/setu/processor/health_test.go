@@ -0,0 +1,89 @@
+package processor
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+)
+
+// registeringQueue keeps the registered tasks to call them directly
+type registeringQueue struct {
+	queue.TaskQueue
+
+	tasks map[string]interface{}
+}
+
+func (q *registeringQueue) RegisterTask(name string, taskFunc interface{}) error {
+	q.tasks[name] = taskFunc
+	return nil
+}
+
+func TestTrackTaskHealth(t *testing.T) {
+	t.Parallel()
+
+	registered := &registeringQueue{tasks: make(map[string]interface{})}
+	taskQueue := trackTaskHealth(registered, "health-test")
+
+	require.NoError(t, taskQueue.RegisterTask("sendTask", func(payload string, height int64) error {
+		if height == 0 {
+			return errors.New("no height")
+		}
+
+		return nil
+	}))
+
+	// machinery rejects functions without an error result
+	require.Error(t, taskQueue.RegisterTask("invalid", func() {}))
+
+	call := func(payload string, height int64) error {
+		results := reflect.ValueOf(registered.tasks["sendTask"]).Call([]reflect.Value{
+			reflect.ValueOf(payload),
+			reflect.ValueOf(height),
+		})
+
+		err, _ := results[0].Interface().(error)
+
+		return err
+	}
+
+	require.NoError(t, call("event", 1))
+
+	h := ProcessorsHealth()["health-test"]
+	require.Equal(t, uint64(1), h.Succeeded)
+	require.Equal(t, "sendTask", h.LastSuccessTask)
+	require.True(t, h.LastFailure.IsZero())
+
+	require.EqualError(t, call("event", 0), "no height")
+
+	h = ProcessorsHealth()["health-test"]
+	require.Equal(t, uint64(1), h.Succeeded)
+	require.Equal(t, uint64(1), h.Failed)
+	require.Equal(t, 0, h.InFlight)
+	require.Equal(t, "sendTask", h.LastFailureTask)
+	require.Equal(t, "no height", h.LastError)
+
+	require.Nil(t, trackTaskHealth(nil, "health-test"))
+}
+
+func TestRunTrackedMetrics(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, runTracked("metrics-test", "poll", func() error { return nil }))
+	require.Error(t, runTracked("metrics-test", "poll", func() error { return errors.New("failed") }))
+	require.Error(t, runTracked("metrics-test", "poll", func() error {
+		return tasks.NewErrRetryTaskLater("not yet", time.Second)
+	}))
+
+	require.Equal(t, float64(3), testutil.ToFloat64(tasksReceived.WithLabelValues("metrics-test", "poll")))
+	require.Equal(t, float64(1), testutil.ToFloat64(tasksSucceeded.WithLabelValues("metrics-test", "poll")))
+	require.Equal(t, float64(1), testutil.ToFloat64(tasksFailed.WithLabelValues("metrics-test", "poll")))
+	require.Equal(t, float64(1), testutil.ToFloat64(tasksRetried.WithLabelValues("metrics-test", "poll")))
+	require.Equal(t, float64(0), testutil.ToFloat64(tasksInFlight.WithLabelValues("metrics-test")))
+}
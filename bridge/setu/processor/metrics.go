@@ -0,0 +1,318 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tendermint/tendermint/libs/log"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// interval between two lookups of a heimdall transaction while waiting for its inclusion
+	inclusionPollInterval = 5 * time.Second
+
+	// time after which a heimdall transaction is not waited for anymore
+	inclusionTimeout = 10 * time.Minute
+
+	// maximum number of heimdall transactions waited for at once
+	maxTrackedInclusions = 1000
+
+	// maximum number of heimdall transactions looked up per poll, the others are looked up by the next polls
+	maxInclusionLookupsPerPoll = 100
+)
+
+var (
+	tasksReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "processor_tasks_received",
+		Help:      "The total number of tasks run by the processors",
+	}, []string{"processor", "task"})
+
+	tasksSucceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "processor_tasks_succeeded",
+		Help:      "The total number of tasks which succeeded",
+	}, []string{"processor", "task"})
+
+	tasksFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "processor_tasks_failed",
+		Help:      "The total number of tasks which returned an error",
+	}, []string{"processor", "task"})
+
+	tasksRetried = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "processor_tasks_retried",
+		Help:      "The total number of tasks which asked to be retried later",
+	}, []string{"processor", "task"})
+
+	tasksInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "processor_tasks_in_flight",
+		Help:      "Number of tasks being run by the processors",
+	}, []string{"processor"})
+
+	taskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "processor_task_duration_seconds",
+		Help:      "Time spent running a task",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"processor", "task"})
+
+	// 15s to ~4h
+	l1EventInclusionTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "l1_event_inclusion_seconds",
+		Help:      "Time between the root chain block of an event and the heimdall block including its transaction",
+		Buckets:   prometheus.ExponentialBuckets(15, 2, 11),
+	}, []string{"event"})
+
+	l1EventInclusionTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "l1_event_inclusion_timeouts",
+		Help:      "The total number of root chain event transactions not seen on heimdall within 10 minutes of their broadcast",
+	}, []string{"event"})
+
+	l1EventInclusionDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: helper.GetConfig().Chain,
+		Name:      "l1_event_inclusion_dropped",
+		Help:      "The total number of root chain event transactions not tracked as too many were waited for",
+	}, []string{"event"})
+)
+
+// trackL1EventInclusion observes, in the background, the time between the root chain block
+// of an event and the heimdall block including txRes, the transaction sent for that event
+func (bp *BaseProcessor) trackL1EventInclusion(eventName string, blockNumber uint64, txRes sdk.TxResponse) {
+	if txRes.Code != uint32(sdk.CodeOK) || bp.inclusionTracker == nil {
+		return
+	}
+
+	hash, err := hex.DecodeString(txRes.TxHash)
+	if err != nil {
+		return
+	}
+
+	bp.inclusionTracker.track(eventName, blockNumber, hash)
+}
+
+// inclusionClient is the part of the tendermint rpc client used to look up the event transactions
+type inclusionClient interface {
+	Tx(hash []byte, prove bool) (*ctypes.ResultTx, error)
+	Block(height *int64) (*ctypes.ResultBlock, error)
+}
+
+// trackedInclusion is an event transaction waiting for its inclusion on heimdall
+type trackedInclusion struct {
+	eventName   string
+	blockNumber uint64
+	hash        []byte
+	deadline    time.Time
+
+	// time of the root chain block of the event, fetched on the first lookup
+	eventTime time.Time
+}
+
+// inclusionTracker looks up the event transactions of all processors from a single worker. The
+// transactions tracked beyond maxTrackedInclusions are dropped and counted
+type inclusionTracker struct {
+	logger log.Logger
+	client inclusionClient
+
+	// eventTime returns the time of a root chain block
+	eventTime func(blockNumber uint64) (time.Time, error)
+
+	mu      sync.Mutex
+	pending []*trackedInclusion
+	max     int
+
+	// transactions looked up per poll, in turns
+	lookupsPerPoll int
+
+	quit chan struct{}
+}
+
+// newInclusionTracker returns a tracker looking up the transactions with client, nil without client
+func newInclusionTracker(logger log.Logger, client *httpClient.HTTP) *inclusionTracker {
+	if client == nil {
+		return nil
+	}
+
+	return &inclusionTracker{
+		logger:         logger,
+		client:         client,
+		eventTime:      rootChainBlockTime,
+		max:            maxTrackedInclusions,
+		lookupsPerPoll: maxInclusionLookupsPerPoll,
+		quit:           make(chan struct{}),
+	}
+}
+
+// rootChainBlockTime returns the time of a root chain block
+func rootChainBlockTime(blockNumber uint64) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	header, err := helper.GetMainClient().HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	//nolint:gosec
+	return time.Unix(int64(header.Time), 0), nil
+}
+
+// track queues the transaction hash sent for an event of a root chain block
+func (t *inclusionTracker) track(eventName string, blockNumber uint64, hash []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.pending) >= t.max {
+		l1EventInclusionDropped.WithLabelValues(eventName).Inc()
+		return
+	}
+
+	t.pending = append(t.pending, &trackedInclusion{
+		eventName:   eventName,
+		blockNumber: blockNumber,
+		hash:        hash,
+		deadline:    time.Now().Add(inclusionTimeout),
+	})
+}
+
+// start looks up the pending transactions every inclusionPollInterval until stop
+func (t *inclusionTracker) start() {
+	ticker := time.NewTicker(inclusionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.poll()
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// stop stops the worker
+func (t *inclusionTracker) stop() {
+	close(t.quit)
+}
+
+// poll looks up the first lookupsPerPoll pending transactions, removing the included and timed
+// out ones and moving the others after the transactions not looked up
+func (t *inclusionTracker) poll() {
+	t.mu.Lock()
+	pending := append([]*trackedInclusion(nil), t.pending...)
+	t.mu.Unlock()
+
+	if len(pending) > t.lookupsPerPoll {
+		pending = pending[:t.lookupsPerPoll]
+	}
+
+	if len(pending) == 0 {
+		return
+	}
+
+	// the root chain and heimdall block times are fetched once per poll, events often share blocks
+	times := &inclusionTimes{
+		events: make(map[uint64]time.Time),
+		blocks: make(map[int64]time.Time),
+	}
+
+	polled := make(map[*trackedInclusion]bool)
+
+	for _, inclusion := range pending {
+		polled[inclusion] = t.lookup(inclusion, times)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	remaining := make([]*trackedInclusion, 0, len(t.pending))
+	waiting := make([]*trackedInclusion, 0, len(pending))
+
+	for _, inclusion := range t.pending {
+		done, ok := polled[inclusion]
+
+		switch {
+		case !ok:
+			remaining = append(remaining, inclusion)
+		case !done:
+			waiting = append(waiting, inclusion)
+		}
+	}
+
+	t.pending = append(remaining, waiting...)
+}
+
+// inclusionTimes are the block times fetched by a poll
+type inclusionTimes struct {
+	events map[uint64]time.Time // root chain block times by number
+	blocks map[int64]time.Time  // heimdall block times by height
+}
+
+// lookup looks up the transaction of inclusion, it returns true once the transaction is not waited for
+// anymore. The transactions whose block times can not be fetched are looked up again by the next polls
+func (t *inclusionTracker) lookup(inclusion *trackedInclusion, times *inclusionTimes) bool {
+	if time.Now().After(inclusion.deadline) {
+		l1EventInclusionTimeouts.WithLabelValues(inclusion.eventName).Inc()
+		return true
+	}
+
+	if inclusion.eventTime.IsZero() {
+		eventTime, ok := times.events[inclusion.blockNumber]
+		if !ok {
+			var err error
+			if eventTime, err = t.eventTime(inclusion.blockNumber); err != nil {
+				t.logger.Debug("Unable to fetch root chain block of event", "event", inclusion.eventName, "block", inclusion.blockNumber, "error", err)
+				return false
+			}
+
+			times.events[inclusion.blockNumber] = eventTime
+		}
+
+		inclusion.eventTime = eventTime
+	}
+
+	tx, err := t.client.Tx(inclusion.hash, false)
+	if err != nil {
+		// not included yet
+		return false
+	}
+
+	blockTime, ok := times.blocks[tx.Height]
+	if !ok {
+		block, err := t.client.Block(&tx.Height)
+		if err != nil {
+			t.logger.Debug("Unable to fetch heimdall block of event tx", "event", inclusion.eventName, "height", tx.Height, "error", err)
+			return false
+		}
+
+		blockTime = block.Block.Time
+		times.blocks[tx.Height] = blockTime
+	}
+
+	l1EventInclusionTime.WithLabelValues(inclusion.eventName).Observe(blockTime.Sub(inclusion.eventTime).Seconds())
+
+	return true
+}
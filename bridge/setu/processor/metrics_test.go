@@ -0,0 +1,171 @@
+package processor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// testInclusionClient serves the heights of the included transactions, by hash
+type testInclusionClient struct {
+	included  map[string]int64
+	blockTime time.Time
+	lookups   int
+}
+
+func (c *testInclusionClient) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	c.lookups++
+
+	height, ok := c.included[string(hash)]
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+
+	return &ctypes.ResultTx{Height: height}, nil
+}
+
+func (c *testInclusionClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	return &ctypes.ResultBlock{Block: &tmTypes.Block{Header: tmTypes.Header{Height: *height, Time: c.blockTime}}}, nil
+}
+
+func TestInclusionTracker(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Unix(1000, 0)
+	client := &testInclusionClient{included: make(map[string]int64), blockTime: eventTime.Add(30 * time.Second)}
+	tracker := &inclusionTracker{
+		logger: log.NewNopLogger(),
+		client: client,
+		eventTime: func(blockNumber uint64) (time.Time, error) {
+			if blockNumber == 0 {
+				return time.Time{}, errors.New("block not found")
+			}
+
+			return eventTime, nil
+		},
+		max:            3,
+		lookupsPerPoll: 3,
+		quit:           make(chan struct{}),
+	}
+
+	tracker.track("tracker-test", 1, []byte("included"))
+	tracker.track("tracker-test", 1, []byte("pending"))
+	tracker.track("tracker-test", 0, []byte("unknown block"))
+
+	// the tracker is full
+	tracker.track("tracker-test", 1, []byte("dropped"))
+	require.Len(t, tracker.pending, 3)
+	require.Equal(t, float64(1), testutil.ToFloat64(l1EventInclusionDropped.WithLabelValues("tracker-test")))
+
+	// the included tx is observed, the tx of an unknown block is looked up again by the next polls
+	client.included["included"] = 5
+	tracker.poll()
+	require.Len(t, tracker.pending, 2)
+	require.Equal(t, []byte("pending"), tracker.pending[0].hash)
+	require.Equal(t, []byte("unknown block"), tracker.pending[1].hash)
+	require.Equal(t, 1, testutil.CollectAndCount(l1EventInclusionTime.WithLabelValues("tracker-test")))
+
+	// the pending txs are waited for until their deadline
+	tracker.poll()
+	require.Len(t, tracker.pending, 2)
+
+	tracker.pending[0].deadline = time.Now().Add(-time.Second)
+	tracker.pending[1].deadline = time.Now().Add(-time.Second)
+	lookups := client.lookups
+
+	tracker.poll()
+	require.Empty(t, tracker.pending)
+	require.Equal(t, lookups, client.lookups)
+	require.Equal(t, float64(2), testutil.ToFloat64(l1EventInclusionTimeouts.WithLabelValues("tracker-test")))
+
+	// the worker stops
+	done := make(chan struct{})
+
+	go func() {
+		tracker.start()
+		close(done)
+	}()
+
+	tracker.stop()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("inclusion tracker did not stop")
+	}
+}
+
+func TestInclusionTrackerLookupsPerPoll(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Unix(1000, 0)
+	client := &testInclusionClient{included: make(map[string]int64), blockTime: eventTime.Add(30 * time.Second)}
+	eventTimes := 0
+	tracker := &inclusionTracker{
+		logger: log.NewNopLogger(),
+		client: client,
+		eventTime: func(uint64) (time.Time, error) {
+			eventTimes++
+			return eventTime, nil
+		},
+		max:            5,
+		lookupsPerPoll: 2,
+		quit:           make(chan struct{}),
+	}
+
+	for _, hash := range []string{"a", "b", "c", "d", "e"} {
+		tracker.track("lookups-test", 1, []byte(hash))
+	}
+
+	// the txs are looked up in turns, the root chain block time once per poll
+	tracker.poll()
+	require.Equal(t, 2, client.lookups)
+	require.Equal(t, 1, eventTimes)
+
+	var hashes []string
+	for _, inclusion := range tracker.pending {
+		hashes = append(hashes, string(inclusion.hash))
+	}
+
+	require.Equal(t, []string{"c", "d", "e", "a", "b"}, hashes)
+
+	client.included["c"] = 5
+	client.included["e"] = 5
+
+	tracker.poll()
+	tracker.poll()
+	require.Equal(t, 6, client.lookups)
+
+	hashes = nil
+	for _, inclusion := range tracker.pending {
+		hashes = append(hashes, string(inclusion.hash))
+	}
+
+	require.Equal(t, []string{"b", "d", "a"}, hashes)
+}
+
+func TestTrackL1EventInclusion(t *testing.T) {
+	t.Parallel()
+
+	// a processor without tracker does not track
+	bp := &BaseProcessor{}
+	bp.trackL1EventInclusion("track-test", 1, sdk.TxResponse{TxHash: "ab"})
+
+	bp.inclusionTracker = &inclusionTracker{max: 1}
+
+	// failed txs and invalid hashes are not tracked
+	bp.trackL1EventInclusion("track-test", 1, sdk.TxResponse{TxHash: "ab", Code: 1})
+	bp.trackL1EventInclusion("track-test", 1, sdk.TxResponse{TxHash: "not hex"})
+	require.Empty(t, bp.inclusionTracker.pending)
+
+	bp.trackL1EventInclusion("track-test", 1, sdk.TxResponse{TxHash: "ab"})
+	require.Len(t, bp.inclusionTracker.pending, 1)
+	require.Equal(t, []byte{0xab}, bp.inclusionTracker.pending[0].hash)
+}
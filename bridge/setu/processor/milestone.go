@@ -29,6 +29,7 @@ type MilestoneProcessor struct {
 // MilestoneContext represents milestone context
 type MilestoneContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
+	MilestoneParams    *milestoneTypes.MilestoneParams
 }
 
 // Start starts new block subscription
@@ -41,9 +42,9 @@ func (mp *MilestoneProcessor) Start() error {
 	mp.cancelMilestoneService = cancelMilestoneService
 
 	// start polling for milestone
-	mp.Logger.Info("Start polling for milestone", "milestoneLength", helper.MilestoneLength, "pollInterval", helper.GetConfig().MilestonePollInterval)
+	mp.Logger.Info("Start polling for milestone", "pollInterval", helper.GetConfig().MilestonePollInterval)
 
-	go mp.startPolling(milestoneCtx, helper.MilestoneLength, helper.GetConfig().MilestonePollInterval)
+	go mp.startPolling(milestoneCtx, helper.GetConfig().MilestonePollInterval)
 	go mp.startPollingMilestoneTimeout(milestoneCtx, 2*helper.GetConfig().MilestonePollInterval)
 
 	return nil
@@ -55,7 +56,7 @@ func (mp *MilestoneProcessor) RegisterTasks() {
 }
 
 // startPolling - polls heimdall and checks if new milestone needs to be proposed
-func (mp *MilestoneProcessor) startPolling(ctx context.Context, milestoneLength uint64, interval time.Duration) {
+func (mp *MilestoneProcessor) startPolling(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	// stop ticker when everything done
 	defer ticker.Stop()
@@ -63,7 +64,7 @@ func (mp *MilestoneProcessor) startPolling(ctx context.Context, milestoneLength
 	for {
 		select {
 		case <-ticker.C:
-			err := mp.checkAndPropose(milestoneLength)
+			err := runTracked(mp.name, "checkAndPropose", mp.checkAndPropose)
 			if err != nil {
 				mp.Logger.Error("Error in proposing the milestone", "error", err)
 			}
@@ -78,7 +79,7 @@ func (mp *MilestoneProcessor) startPolling(ctx context.Context, milestoneLength
 // 1. check if i am the proposer for next milestone
 // 2. check if milestone has to be proposed
 // 3. if so, propose milestone to heimdall.
-func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error) {
+func (mp *MilestoneProcessor) checkAndPropose() (err error) {
 	//Milestone proposing mechanism will work only after specific block height
 	if util.GetBlockHeight(mp.cliCtx) < helper.GetAalborgHardForkHeight() {
 		mp.Logger.Debug("Block height Less than fork height", "current block height", util.GetBlockHeight(mp.cliCtx), "milestone hard fork height", helper.GetAalborgHardForkHeight())
@@ -126,7 +127,7 @@ func (mp *MilestoneProcessor) checkAndPropose(milestoneLength uint64) (err error
 		}
 
 		//send the milestone to heimdall chain
-		if err := mp.createAndSendMilestoneToHeimdall(milestoneContext, start, milestoneLength); err != nil {
+		if err := mp.createAndSendMilestoneToHeimdall(milestoneContext, start, milestoneContext.MilestoneParams.MilestoneLength); err != nil {
 			mp.Logger.Error("Error sending milestone to heimdall", "error", err)
 			return err
 		}
@@ -213,7 +214,7 @@ func (mp *MilestoneProcessor) startPollingMilestoneTimeout(ctx context.Context,
 	for {
 		select {
 		case <-ticker.C:
-			err := mp.checkAndProposeMilestoneTimeout()
+			err := runTracked(mp.name, "checkAndProposeMilestoneTimeout", mp.checkAndProposeMilestoneTimeout)
 			if err != nil {
 				mp.Logger.Error("Error in proposing the MilestoneTimeout msg", "error", err)
 			}
@@ -297,6 +298,11 @@ func (mp *MilestoneProcessor) checkIfMilestoneTimeoutIsRequired() (bool, error)
 		return false, err
 	}
 
+	milestoneParams, err := util.GetMilestoneParams(mp.cliCtx)
+	if err != nil {
+		return false, err
+	}
+
 	lastMilestoneEndBlock := latestMilestone.EndBlock
 	currentChildBlockNumber, _ := mp.getCurrentChildBlock()
 
@@ -304,7 +310,7 @@ func (mp *MilestoneProcessor) checkIfMilestoneTimeoutIsRequired() (bool, error)
 		return false, err
 	}
 
-	if (currentChildBlockNumber - lastMilestoneEndBlock) > helper.MilestoneBufferLength {
+	if (currentChildBlockNumber - lastMilestoneEndBlock) > milestoneParams.MilestoneBufferLength {
 		return true, nil
 	}
 
@@ -328,8 +334,15 @@ func (mp *MilestoneProcessor) getMilestoneContext() (*MilestoneContext, error) {
 		return nil, err
 	}
 
+	milestoneParams, err := util.GetMilestoneParams(mp.cliCtx)
+	if err != nil {
+		mp.Logger.Error("Error while fetching milestone params", "error", err)
+		return nil, err
+	}
+
 	return &MilestoneContext{
 		ChainmanagerParams: chainmanagerParams,
+		MilestoneParams:    milestoneParams,
 	}, nil
 }
 
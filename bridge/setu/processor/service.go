@@ -22,22 +22,26 @@ type ProcessorService struct {
 	common.BaseService
 
 	// queue connector
-	queueConnector *queue.QueueConnector
+	queueConnector queue.TaskQueue
 
 	processors []Processor
+
+	// tracker of the inclusion of the event transactions of the processors
+	inclusionTracker *inclusionTracker
 }
 
 // NewProcessorService returns new service object for processing queue msg
 func NewProcessorService(
 	cdc *codec.Codec,
-	queueConnector *queue.QueueConnector,
+	queueConnector queue.TaskQueue,
 	httpClient *httpClient.HTTP,
 	txBroadcaster *broadcaster.TxBroadcaster,
 ) *ProcessorService {
 	var logger = util.Logger().With("module", processorServiceStr)
 	// creating processor object
 	processorService := &ProcessorService{
-		queueConnector: queueConnector,
+		queueConnector:   queueConnector,
+		inclusionTracker: newInclusionTracker(logger.With("service", "inclusion-tracker"), httpClient),
 	}
 
 	contractCaller, err := helper.NewContractCaller()
@@ -54,6 +58,7 @@ func NewProcessorService(
 	// initialize checkpoint processor
 	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI)
 	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "checkpoint", checkpointProcessor)
+	checkpointProcessor.inclusionTracker = processorService.inclusionTracker
 
 	// initialize checkpoint processor
 	milestoneProcessor := &MilestoneProcessor{}
@@ -62,14 +67,17 @@ func NewProcessorService(
 	// initialize fee processor
 	feeProcessor := NewFeeProcessor(&contractCaller.StakingInfoABI)
 	feeProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "fee", feeProcessor)
+	feeProcessor.inclusionTracker = processorService.inclusionTracker
 
 	// initialize staking processor
 	stakingProcessor := NewStakingProcessor(&contractCaller.StakingInfoABI)
 	stakingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "staking", stakingProcessor)
+	stakingProcessor.inclusionTracker = processorService.inclusionTracker
 
 	// initialize clerk processor
 	clerkProcessor := NewClerkProcessor(&contractCaller.StateSenderABI)
 	clerkProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "clerk", clerkProcessor)
+	clerkProcessor.inclusionTracker = processorService.inclusionTracker
 
 	// initialize span processor
 	spanProcessor := &SpanProcessor{}
@@ -78,6 +86,7 @@ func NewProcessorService(
 	// initialize slashing processor
 	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
 	slashingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "slashing", slashingProcessor)
+	slashingProcessor.inclusionTracker = processorService.inclusionTracker
 
 	//
 	// Select processors
@@ -142,6 +151,10 @@ func (processorService *ProcessorService) OnStart() error {
 		}(processor)
 	}
 
+	if processorService.inclusionTracker != nil {
+		go processorService.inclusionTracker.start()
+	}
+
 	processorService.Logger.Info("all processors Started")
 
 	return nil
@@ -155,5 +168,9 @@ func (processorService *ProcessorService) OnStop() {
 		processor.Stop()
 	}
 
+	if processorService.inclusionTracker != nil {
+		processorService.inclusionTracker.stop()
+	}
+
 	processorService.Logger.Info("all processors stopped")
 }
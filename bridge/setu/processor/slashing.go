@@ -16,6 +16,7 @@ import (
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/client/restclient"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
@@ -51,19 +52,19 @@ func (sp *SlashingProcessor) Start() error {
 func (sp *SlashingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering slashing related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickToHeimdall", sp.sendTickToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendTickToHeimdall", sp.sendTickToHeimdall); err != nil {
 		sp.Logger.Error("Failed to register sendTickToHeimdall task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain); err != nil {
 		sp.Logger.Error("Failed to register sendTickToRootchain task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall); err != nil {
 		sp.Logger.Error("Failed to register sendTickAckToHeimdall task", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall); err != nil {
 		sp.Logger.Error("Failed to register sendUnjailToHeimdall task", "error", err)
 	}
 }
@@ -248,6 +249,8 @@ func (sp *SlashingProcessor) sendTickAckToHeimdall(eventName string, logBytes st
 			return fmt.Errorf("tick-ack tx failed, tx response code: %v", txRes.Code)
 
 		}
+
+		sp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
@@ -310,6 +313,8 @@ func (sp *SlashingProcessor) sendUnjailToHeimdall(eventName string, logBytes str
 			return fmt.Errorf("unjail tx failed, tx response code: %v", txRes.Code)
 
 		}
+
+		sp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
@@ -365,19 +370,21 @@ func (sp *SlashingProcessor) createAndSendTickToRootchain(height int64, txHash [
 	chainParams := slashingContrext.ChainmanagerParams.ChainParams
 	slashManagerAddress := chainParams.SlashManagerAddress.EthAddress()
 
-	// slashmanage instance
-	slashManagerInstance, err := sp.contractConnector.GetSlashManagerInstance(slashManagerAddress)
+	// TODO pass sigs in proper form in `updateSlashedAmounts` for slashing
+	data, err := sp.contractConnector.SlashManagerABI.Pack("updateSlashedAmounts", sideTxData, []byte(nil))
 	if err != nil {
-		sp.Logger.Info("Error while creating slashmanager instance", "error", err)
+		sp.Logger.Error("Unable to pack tx for updateSlashedAmounts", "error", err)
 		return err
 	}
 
-	// TODO pass sigs in proper form in `SendTick` for slashing
-	if err := sp.contractConnector.SendTick(sideTxData, nil, slashManagerAddress, slashManagerInstance); err != nil {
+	rootTxHash, err := sp.txBroadcaster.BroadcastToRootchain(slashManagerAddress, data, fmt.Sprintf("tick at heimdall height %d", height))
+	if err != nil {
 		sp.Logger.Info("Error submitting tick to slashManager contract", "error", err)
 		return err
 	}
 
+	sp.Logger.Info("Submitted new tick to slashManager contract", "txHash", rootTxHash.Hex())
+
 	return nil
 }
 
@@ -385,7 +392,7 @@ func (sp *SlashingProcessor) createAndSendTickToRootchain(height int64, txHash [
 func (sp *SlashingProcessor) fetchLatestSlashInoBytes() (slashInfoBytes hmTypes.HexBytes, err error) {
 	sp.Logger.Info("Sending Rest call to Get Latest SlashInfoBytes")
 
-	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.LatestSlashInfoBytesURL))
+	slashInfoBytes, _, err = restclient.New(sp.cliCtx).GetLatestSlashInfoBytes()
 	if err != nil {
 		sp.Logger.Error("Error Fetching slashInfoBytes from HeimdallServer ", "error", err)
 		return slashInfoBytes, err
@@ -393,11 +400,6 @@ func (sp *SlashingProcessor) fetchLatestSlashInoBytes() (slashInfoBytes hmTypes.
 
 	sp.Logger.Info("Latest slashInfoBytes fetched")
 
-	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &slashInfoBytes); err != nil {
-		sp.Logger.Error("Error unmarshalling latest slashInfoBytes received from Heimdall Server", "error", err)
-		return slashInfoBytes, err
-	}
-
 	return slashInfoBytes, nil
 }
 
@@ -405,17 +407,12 @@ func (sp *SlashingProcessor) fetchLatestSlashInoBytes() (slashInfoBytes hmTypes.
 func (sp *SlashingProcessor) fetchTickCount() (tickCount uint64, err error) {
 	sp.Logger.Info("Sending Rest call to Get Tick count")
 
-	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.SlashingTickCountURL))
+	tickCount, _, err = restclient.New(sp.cliCtx).GetSlashingTickCount()
 	if err != nil {
 		sp.Logger.Error("Error while sending request for tick count", "Error", err)
 		return tickCount, err
 	}
 
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &tickCount); err != nil {
-		sp.Logger.Error("Error unmarshalling tick count data ", "error", err)
-		return tickCount, err
-	}
-
 	return tickCount, nil
 }
 
@@ -423,7 +420,7 @@ func (sp *SlashingProcessor) fetchTickCount() (tickCount uint64, err error) {
 func (sp *SlashingProcessor) fetchTickSlashInfoList() (slashInfoList []*hmTypes.ValidatorSlashingInfo, err error) {
 	sp.Logger.Info("Sending Rest call to Get Tick SlashInfo list")
 
-	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.TickSlashInfoListURL))
+	slashInfoList, _, err = restclient.New(sp.cliCtx).GetTickSlashInfos()
 	if err != nil {
 		sp.Logger.Error("Error Fetching Tick slashInfoList from HeimdallServer ", "error", err)
 		return slashInfoList, err
@@ -431,11 +428,6 @@ func (sp *SlashingProcessor) fetchTickSlashInfoList() (slashInfoList []*hmTypes.
 
 	sp.Logger.Info("Tick SlashInfo List fetched")
 
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &slashInfoList); err != nil {
-		sp.Logger.Error("Error unmarshalling tick slashinfo list received from Heimdall Server", "error", err)
-		return slashInfoList, err
-	}
-
 	return slashInfoList, nil
 }
 
@@ -3,18 +3,15 @@ package processor
 import (
 	"bytes"
 	"context"
-	"fmt"
-	"net/http"
-	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	jsoniter "github.com/json-iterator/go"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/client/restclient"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
@@ -59,7 +56,7 @@ func (sp *SpanProcessor) startPolling(ctx context.Context, interval time.Duratio
 		select {
 		case <-ticker.C:
 			// nolint: contextcheck
-			sp.checkAndPropose()
+			_ = runTracked(sp.name, "checkAndPropose", sp.checkAndPropose)
 		case <-ctx.Done():
 			sp.Logger.Info("Polling stopped")
 			ticker.Stop()
@@ -70,33 +67,33 @@ func (sp *SpanProcessor) startPolling(ctx context.Context, interval time.Duratio
 }
 
 // checkAndPropose - will check if current user is span proposer and proposes the span
-func (sp *SpanProcessor) checkAndPropose() {
+func (sp *SpanProcessor) checkAndPropose() error {
 	lastSpan, err := sp.getLastSpan()
 	if err != nil {
 		sp.Logger.Error("Unable to fetch last span", "error", err)
-		return
+		return err
 	}
 
 	if lastSpan == nil {
-		return
+		return nil
 	}
 
 	nodeStatus, err := helper.GetNodeStatus(sp.cliCtx)
 	if err != nil {
 		sp.Logger.Error("Error while fetching heimdall node status", "error", err)
-		return
+		return err
 	}
 
 	if nodeStatus.SyncInfo.LatestBlockHeight >= helper.GetDanelawHeight() {
 		latestBlock, e := sp.contractConnector.GetMaticChainBlock(nil)
 		if e != nil {
 			sp.Logger.Error("Error fetching current child block", "error", e)
-			return
+			return e
 		}
 
 		if latestBlock.Number.Uint64() < lastSpan.StartBlock {
 			sp.Logger.Debug("Current bor block is less than last span start block, skipping proposing span", "currentBlock", latestBlock.Number.Uint64(), "lastSpanStartBlock", lastSpan.StartBlock)
-			return
+			return nil
 		}
 	}
 
@@ -105,13 +102,15 @@ func (sp *SpanProcessor) checkAndPropose() {
 	nextSpanMsg, err := sp.fetchNextSpanDetails(lastSpan.ID+1, lastSpan.EndBlock+1)
 	if err != nil {
 		sp.Logger.Error("Unable to fetch next span details", "error", err, "lastSpanId", lastSpan.ID)
-		return
+		return err
 	}
 
 	// check if current user is among next span producers
 	if sp.isSpanProposer(nextSpanMsg.SelectedProducers) {
 		go sp.propose(lastSpan, nextSpanMsg)
 	}
+
+	return nil
 }
 
 // propose producers for next span if needed
@@ -187,15 +186,9 @@ func (sp *SpanProcessor) propose(lastSpan *types.Span, nextSpanMsg *types.Span)
 // checks span status
 func (sp *SpanProcessor) getLastSpan() (*types.Span, error) {
 	// fetch latest start block from heimdall via rest query
-	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.LatestSpanURL))
+	lastSpan, _, err := restclient.New(sp.cliCtx).GetLatestSpan()
 	if err != nil {
-		sp.Logger.Error("Error while fetching latest span")
-		return nil, err
-	}
-
-	var lastSpan types.Span
-	if err = jsoniter.ConfigFastest.Unmarshal(result.Result, &lastSpan); err != nil {
-		sp.Logger.Error("Error unmarshalling span", "error", err)
+		sp.Logger.Error("Error while fetching latest span", "error", err)
 		return nil, err
 	}
 
@@ -226,38 +219,19 @@ func (sp *SpanProcessor) isSpanProposer(nextSpanProducers []types.Validator) boo
 
 // fetch next span details from heimdall.
 func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.Span, error) {
-	req, err := http.NewRequest("GET", helper.GetHeimdallServerEndpoint(util.NextSpanInfoURL), nil)
-	if err != nil {
-		sp.Logger.Error("Error creating a new request", "error", err)
-		return nil, err
-	}
-
 	configParams, err := util.GetChainmanagerParams(sp.cliCtx)
 	if err != nil {
 		sp.Logger.Error("Error while fetching chainmanager params", "error", err)
 		return nil, err
 	}
 
-	q := req.URL.Query()
-	q.Add("span_id", strconv.FormatUint(id, 10))
-	q.Add("start_block", strconv.FormatUint(start, 10))
-	q.Add("chain_id", configParams.ChainParams.BorChainID)
-	q.Add("proposer", helper.GetFromAddress(sp.cliCtx).String())
-	req.URL.RawQuery = q.Encode()
-
 	// fetch next span details
-	result, err := helper.FetchFromAPI(sp.cliCtx, req.URL.String())
+	msg, _, err := restclient.New(sp.cliCtx).PrepareNextSpan(id, start, configParams.ChainParams.BorChainID)
 	if err != nil {
 		sp.Logger.Error("Error fetching proposers", "error", err)
 		return nil, err
 	}
 
-	var msg types.Span
-	if err = jsoniter.ConfigFastest.Unmarshal(result.Result, &msg); err != nil {
-		sp.Logger.Error("Error unmarshalling propose tx msg ", "error", err)
-		return nil, err
-	}
-
 	sp.Logger.Debug("◽ Generated proposer span msg", "msg", msg.String())
 
 	return &msg, nil
@@ -267,7 +241,7 @@ func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.S
 func (sp *SpanProcessor) fetchNextSpanSeed(id uint64) (common.Hash, common.Address, error) {
 	sp.Logger.Info("Sending Rest call to Get Seed for next span")
 
-	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.NextSpanSeedURL, strconv.FormatUint(id, 10))))
+	nextSpanSeedResponse, _, err := restclient.New(sp.cliCtx).GetNextSpanSeed(id)
 	if err != nil {
 		sp.Logger.Error("Error Fetching nextspanseed from HeimdallServer ", "error", err)
 		return common.Hash{}, common.Address{}, err
@@ -275,13 +249,6 @@ func (sp *SpanProcessor) fetchNextSpanSeed(id uint64) (common.Hash, common.Addre
 
 	sp.Logger.Info("Next span seed fetched")
 
-	var nextSpanSeedResponse borTypes.QuerySpanSeedResponse
-
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &nextSpanSeedResponse); err != nil {
-		sp.Logger.Error("Error unmarshalling nextSpanSeed received from Heimdall Server", "error", err)
-		return common.Hash{}, common.Address{}, err
-	}
-
 	return nextSpanSeedResponse.Seed, nextSpanSeedResponse.SeedAuthor, nil
 }
 
@@ -48,19 +48,19 @@ func (sp *StakingProcessor) Start() error {
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
 
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendValidatorJoinToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendUnstakeInitToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
 	}
 
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSignerChangeToHeimdall", "error", err)
 	}
 }
@@ -145,6 +145,8 @@ func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logByt
 			return fmt.Errorf("validator-join tx failed, tx response code: %v", txRes.Code)
 
 		}
+
+		sp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
@@ -228,6 +230,8 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 			return fmt.Errorf("unstakeInit tx failed, tx response code: %v", txRes.Code)
 
 		}
+
+		sp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
@@ -306,6 +310,8 @@ func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes
 			sp.Logger.Error("stakeupdate tx failed on heimdall", "txHash", txRes.TxHash, "code", txRes.Code)
 			return fmt.Errorf("stakeupdate tx failed, tx response code: %v", txRes.Code)
 		}
+
+		sp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
@@ -393,6 +399,8 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 			sp.Logger.Error("signerChange tx failed on heimdall", "txHash", txRes.TxHash, "code", txRes.Code)
 			return fmt.Errorf("signerChange tx failed, tx response code: %v", txRes.Code)
 		}
+
+		sp.trackL1EventInclusion(eventName, vLog.BlockNumber, txRes)
 	}
 
 	return nil
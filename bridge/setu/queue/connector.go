@@ -1,30 +1,55 @@
 package queue
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
 	"github.com/streadway/amqp"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
 	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
+// QueueConnector is the AMQP (RabbitMQ) task queue backend
 type QueueConnector struct {
 	logger log.Logger
 	Server *machinery.Server
+
+	// broker url
+	dialer string
+
+	worker *machinery.Worker
+
+	// failed tasks, nil disables recording
+	deadLetters *DeadLetterStore
+
+	// connection and channel kept to inspect the queue, reopened after an error
+	inspectMu      sync.Mutex
+	inspectConn    *amqp.Connection
+	inspectChannel *amqp.Channel
 }
 
 const (
 	// machinery task queue
 	QueueName = "machinery_tasks"
+
+	// machinery error callback which records failed tasks
+	recordFailedTaskName = "recordFailedTask"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
+// NewQueueConnector creates a machinery server backed by the AMQP broker at dialer
+func NewQueueConnector(dialer string, deadLetters *DeadLetterStore) (*QueueConnector, error) {
 	// amqp dialer
-	_, err := amqp.Dial(dialer)
+	conn, err := amqp.Dial(dialer)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	var cnf = &config.Config{
@@ -40,26 +65,166 @@ func NewQueueConnector(dialer string) *QueueConnector {
 
 	server, err := machinery.NewServer(cnf)
 	if err != nil {
-		panic(err)
+		_ = conn.Close()
+		return nil, err
 	}
 
 	// queue connector
 	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+		logger:      util.Logger().With("module", "QueueConnector"),
+		Server:      server,
+		dialer:      dialer,
+		deadLetters: deadLetters,
+
+		// the connection opened to check the broker is reachable is kept to inspect the queue
+		inspectConn: conn,
+	}
+
+	if err := server.RegisterTask(recordFailedTaskName, connector.recordFailedTask); err != nil {
+		_ = conn.Close()
+		return nil, err
 	}
 
 	// connector
-	return &connector
+	return &connector, nil
+}
+
+// RegisterTask registers task handler with machinery
+func (qc *QueueConnector) RegisterTask(name string, taskFunc interface{}) error {
+	return qc.Server.RegisterTask(name, taskFunc)
+}
+
+// SendTask publishes task to the broker. When the task exhausts its retries
+// machinery runs the recordFailedTask error callback with the original signature.
+func (qc *QueueConnector) SendTask(signature *tasks.Signature) error {
+	if signature.UUID == "" {
+		signature.UUID = fmt.Sprintf("task_%v", uuid.New().String())
+	}
+
+	setEnqueuedAt(signature)
+
+	if qc.deadLetters != nil && signature.Name != recordFailedTaskName {
+		original := *signature
+		original.OnError = nil
+
+		signatureBytes, err := json.Marshal(&original)
+		if err != nil {
+			return err
+		}
+
+		signature.OnError = []*tasks.Signature{
+			{
+				Name: recordFailedTaskName,
+				Args: []tasks.Arg{
+					{
+						Type:  "string",
+						Value: string(signatureBytes),
+					},
+				},
+			},
+		}
+	}
+
+	_, err := qc.Server.SendTask(signature)
+
+	return err
+}
+
+// recordFailedTask stores a task which exhausted its retries, machinery passes
+// the task error as first argument to error callbacks
+func (qc *QueueConnector) recordFailedTask(taskErr string, signatureJSON string) error {
+	signature, err := decodeSignature([]byte(signatureJSON))
+	if err != nil {
+		qc.logger.Error("Error while decoding failed task", "error", err)
+		return err
+	}
+
+	qc.logger.Error("Task failed", "taskName", signature.Name, "uuid", signature.UUID, "error", taskErr)
+
+	// machinery only retries on errors counted by RetryCount
+	return qc.deadLetters.Add(signature, signature.RetryCount+1, errors.New(taskErr))
 }
 
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
-	worker := qc.Server.NewWorker("invoke-processor", 10)
+	qc.worker = qc.Server.NewWorker("invoke-processor", 10)
 
 	qc.logger.Info("Starting machinery worker")
 
-	errors := make(chan error)
+	errorsChan := make(chan error)
 
-	worker.LaunchAsync(errors)
+	qc.worker.LaunchAsync(errorsChan)
+}
+
+// Stop stops consuming tasks from the broker
+func (qc *QueueConnector) Stop() {
+	if qc.worker != nil {
+		qc.worker.Quit()
+	}
+
+	qc.inspectMu.Lock()
+	defer qc.inspectMu.Unlock()
+
+	qc.closeInspect()
+}
+
+// QueuedTasks returns the number of messages ready in the broker queue. The queue is inspected
+// over a connection kept open between the calls
+func (qc *QueueConnector) QueuedTasks() (int, error) {
+	qc.inspectMu.Lock()
+	defer qc.inspectMu.Unlock()
+
+	if err := qc.openInspect(); err != nil {
+		return 0, err
+	}
+
+	queue, err := qc.inspectChannel.QueueInspect(QueueName)
+	if err != nil {
+		// the broker closes the channel on errors, reopen both on the next call
+		qc.closeInspect()
+		return 0, err
+	}
+
+	return queue.Messages, nil
+}
+
+// openInspect opens the inspection connection and channel if they are not open
+func (qc *QueueConnector) openInspect() error {
+	if qc.inspectConn != nil && qc.inspectConn.IsClosed() {
+		qc.closeInspect()
+	}
+
+	if qc.inspectConn == nil {
+		conn, err := amqp.Dial(qc.dialer)
+		if err != nil {
+			return err
+		}
+
+		qc.inspectConn = conn
+	}
+
+	if qc.inspectChannel == nil {
+		channel, err := qc.inspectConn.Channel()
+		if err != nil {
+			qc.closeInspect()
+			return err
+		}
+
+		qc.inspectChannel = channel
+	}
+
+	return nil
+}
+
+// closeInspect closes the inspection connection and channel
+func (qc *QueueConnector) closeInspect() {
+	if qc.inspectChannel != nil {
+		_ = qc.inspectChannel.Close()
+		qc.inspectChannel = nil
+	}
+
+	if qc.inspectConn != nil {
+		_ = qc.inspectConn.Close()
+		qc.inspectConn = nil
+	}
 }
@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// dead letter storage prefix
+	deadLetterPrefix = "task-queue-dead-" // + task uuid
+
+	// task headers used to track the history of a task across retries
+	enqueuedAtHeader = "heimdall-enqueued-at"
+	attemptsHeader   = "heimdall-attempts"
+)
+
+// ErrFailedTaskNotFound is returned when there is no failed task for the given uuid
+var ErrFailedTaskNotFound = errors.New("failed task not found")
+
+// FailedTask is a bridge task which exhausted its retries
+type FailedTask struct {
+	UUID       string      `json:"uuid"`
+	Name       string      `json:"name"`
+	Args       []tasks.Arg `json:"args"`
+	Error      string      `json:"error"`
+	Attempts   int         `json:"attempts"`
+	EnqueuedAt time.Time   `json:"enqueued_at"`
+	FailedAt   time.Time   `json:"failed_at"`
+}
+
+// Signature returns a new signature which runs the failed task again
+func (ft *FailedTask) Signature(retryCount int) *tasks.Signature {
+	return &tasks.Signature{
+		Name:       ft.Name,
+		Args:       ft.Args,
+		RetryCount: retryCount,
+	}
+}
+
+// DeadLetterStore keeps failed tasks in the bridge db so they can be inspected and replayed
+type DeadLetterStore struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterStore creates dead letter store on top of db
+func NewDeadLetterStore(db *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{db: db}
+}
+
+// Add records failed task
+func (s *DeadLetterStore) Add(signature *tasks.Signature, attempts int, taskErr error) error {
+	failedTask := FailedTask{
+		UUID:       signature.UUID,
+		Name:       signature.Name,
+		Args:       signature.Args,
+		Error:      taskErr.Error(),
+		Attempts:   attempts,
+		EnqueuedAt: enqueuedAt(signature),
+		FailedAt:   time.Now().UTC(),
+	}
+
+	value, err := json.Marshal(failedTask)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(deadLetterKey(signature.UUID), value, nil)
+}
+
+// Get returns failed task by uuid
+func (s *DeadLetterStore) Get(taskUUID string) (*FailedTask, error) {
+	value, err := s.db.Get(deadLetterKey(taskUUID), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrFailedTaskNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeFailedTask(value)
+}
+
+// List returns all failed tasks, oldest failure first
+func (s *DeadLetterStore) List() ([]*FailedTask, error) {
+	iter := s.db.NewIterator(leveldbUtil.BytesPrefix([]byte(deadLetterPrefix)), nil)
+	defer iter.Release()
+
+	failedTasks := make([]*FailedTask, 0)
+
+	for iter.Next() {
+		failedTask, err := decodeFailedTask(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		failedTasks = append(failedTasks, failedTask)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(failedTasks, func(i, j int) bool {
+		return failedTasks[i].FailedAt.Before(failedTasks[j].FailedAt)
+	})
+
+	return failedTasks, nil
+}
+
+// Delete removes failed task
+func (s *DeadLetterStore) Delete(taskUUID string) error {
+	if ok, err := s.db.Has(deadLetterKey(taskUUID), nil); err != nil {
+		return err
+	} else if !ok {
+		return ErrFailedTaskNotFound
+	}
+
+	return s.db.Delete(deadLetterKey(taskUUID), nil)
+}
+
+// decodeFailedTask unmarshals a stored failed task keeping numbers in args as json.Number
+func decodeFailedTask(value []byte) (*FailedTask, error) {
+	failedTask := new(FailedTask)
+
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(failedTask); err != nil {
+		return nil, err
+	}
+
+	return failedTask, nil
+}
+
+func deadLetterKey(taskUUID string) []byte {
+	return append([]byte(deadLetterPrefix), taskUUID...)
+}
+
+// setEnqueuedAt stamps the time the task entered the queue for the first time
+func setEnqueuedAt(signature *tasks.Signature) {
+	if signature.Headers == nil {
+		signature.Headers = make(tasks.Headers)
+	}
+
+	if _, ok := signature.Headers[enqueuedAtHeader]; !ok {
+		signature.Headers.Set(enqueuedAtHeader, time.Now().UTC().Format(time.RFC3339Nano))
+	}
+}
+
+// enqueuedAt returns the time the task entered the queue for the first time
+func enqueuedAt(signature *tasks.Signature) time.Time {
+	value, _ := signature.Headers[enqueuedAtHeader].(string)
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// taskAttempts returns how many times the task was executed
+func taskAttempts(signature *tasks.Signature) int {
+	switch value := signature.Headers[attemptsHeader].(type) {
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			return int(n)
+		}
+	case int:
+		return value
+	}
+
+	return 0
+}
+
+// incrementAttempts counts a task execution
+func incrementAttempts(signature *tasks.Signature) {
+	if signature.Headers == nil {
+		signature.Headers = make(tasks.Headers)
+	}
+
+	signature.Headers[attemptsHeader] = taskAttempts(signature) + 1
+}
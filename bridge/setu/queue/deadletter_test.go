@@ -0,0 +1,88 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewDeadLetterStore(newTestDB(t))
+
+	first := newTestSignature("sendStateSyncedToHeimdall", tasks.Arg{Type: "string", Value: "StateSynced"})
+	first.UUID = "task_1"
+	setEnqueuedAt(first)
+
+	second := newTestSignature("sendBlockTask", tasks.Arg{Type: "int64", Value: int64(42)})
+	second.UUID = "task_2"
+
+	require.NoError(t, store.Add(first, 4, errors.New("state sync failed")))
+	require.NoError(t, store.Add(second, 1, errors.New("broadcast failed")))
+
+	failedTask, err := store.Get("task_1")
+	require.NoError(t, err)
+	require.Equal(t, "sendStateSyncedToHeimdall", failedTask.Name)
+	require.Equal(t, "state sync failed", failedTask.Error)
+	require.Equal(t, 4, failedTask.Attempts)
+	require.False(t, failedTask.EnqueuedAt.IsZero())
+	require.False(t, failedTask.FailedAt.Before(failedTask.EnqueuedAt))
+
+	// int64 args must survive the roundtrip so the task can be replayed
+	failedTask, err = store.Get("task_2")
+	require.NoError(t, err)
+	require.Equal(t, json.Number("42"), failedTask.Args[0].Value)
+
+	replay := failedTask.Signature(3)
+	require.Equal(t, "sendBlockTask", replay.Name)
+	require.Equal(t, 3, replay.RetryCount)
+	require.Empty(t, replay.UUID)
+
+	failedTasks, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, failedTasks, 2)
+	require.Equal(t, "task_1", failedTasks[0].UUID)
+
+	require.NoError(t, store.Delete("task_1"))
+	require.ErrorIs(t, store.Delete("task_1"), ErrFailedTaskNotFound)
+
+	_, err = store.Get("task_1")
+	require.ErrorIs(t, err, ErrFailedTaskNotFound)
+}
+
+func TestEmbeddedQueueRecordsFailedTask(t *testing.T) {
+	t.Parallel()
+
+	eq := NewEmbeddedQueue(newTestDB(t))
+	defer eq.Stop()
+
+	require.NoError(t, eq.RegisterTask("failing", func(arg string) error {
+		return errors.New("bad " + arg)
+	}))
+
+	eq.StartWorker()
+
+	signature := newTestSignature("failing", tasks.Arg{Type: "string", Value: "event"})
+	signature.RetryCount = 1
+
+	require.NoError(t, eq.SendTask(signature))
+
+	var failedTasks []*FailedTask
+
+	require.Eventually(t, func() bool {
+		var err error
+
+		failedTasks, err = eq.deadLetters.List()
+
+		return err == nil && len(failedTasks) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.Equal(t, "failing", failedTasks[0].Name)
+	require.Equal(t, "bad event", failedTasks[0].Error)
+	require.Equal(t, 2, failedTasks[0].Attempts)
+}
@@ -0,0 +1,401 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/retry"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/google/uuid"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	// embedded queue storage prefixes
+	pendingTaskPrefix  = "task-queue-pending-"  // + eta (8 bytes, big endian unix nano) + task uuid
+	inFlightTaskPrefix = "task-queue-inflight-" // + task uuid
+
+	// embedded queue worker settings, same concurrency as the machinery worker
+	embeddedQueueConcurrency  = 10
+	embeddedQueuePollInterval = 1 * time.Second
+
+	// delay before a task without registered handler is looked at again
+	unregisteredTaskDelay = 5 * time.Second
+)
+
+// EmbeddedQueue is a task queue persisted in the bridge db. It lets
+// the bridge run without an external AMQP broker.
+type EmbeddedQueue struct {
+	logger log.Logger
+	db     *leveldb.DB
+
+	// failed tasks
+	deadLetters *DeadLetterStore
+
+	handlersMu sync.RWMutex
+	handlers   map[string]interface{}
+
+	jobs   chan *tasks.Signature
+	notify chan struct{}
+	quit   chan struct{}
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewEmbeddedQueue creates a task queue on top of db
+func NewEmbeddedQueue(db *leveldb.DB) *EmbeddedQueue {
+	return &EmbeddedQueue{
+		logger:      util.Logger().With("module", "EmbeddedQueue"),
+		db:          db,
+		deadLetters: NewDeadLetterStore(db),
+		handlers:    make(map[string]interface{}),
+		jobs:        make(chan *tasks.Signature),
+		notify:      make(chan struct{}, 1),
+		quit:        make(chan struct{}),
+	}
+}
+
+// RegisterTask registers task handler
+func (eq *EmbeddedQueue) RegisterTask(name string, taskFunc interface{}) error {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return err
+	}
+
+	eq.handlersMu.Lock()
+	defer eq.handlersMu.Unlock()
+
+	eq.handlers[name] = taskFunc
+
+	return nil
+}
+
+// SendTask stores task in the bridge db, it will be picked up once its ETA is reached
+func (eq *EmbeddedQueue) SendTask(signature *tasks.Signature) error {
+	if signature.UUID == "" {
+		signature.UUID = fmt.Sprintf("task_%v", uuid.New().String())
+	}
+
+	setEnqueuedAt(signature)
+
+	eta := time.Now()
+	if signature.ETA != nil {
+		eta = *signature.ETA
+	}
+
+	value, err := json.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	if err := eq.db.Put(pendingTaskKey(eta, signature.UUID), value, nil); err != nil {
+		return err
+	}
+
+	// wake up dispatcher
+	select {
+	case eq.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// StartWorker starts dispatcher and workers. Tasks which were in flight
+// when the bridge stopped are put back in the queue first.
+func (eq *EmbeddedQueue) StartWorker() {
+	eq.startOnce.Do(func() {
+		if err := eq.requeueInFlight(); err != nil {
+			eq.logger.Error("Error while requeuing in-flight tasks", "error", err)
+		}
+
+		eq.logger.Info("Starting embedded queue worker", "concurrency", embeddedQueueConcurrency)
+
+		for i := 0; i < embeddedQueueConcurrency; i++ {
+			eq.wg.Add(1)
+
+			go eq.work()
+		}
+
+		eq.wg.Add(1)
+
+		go eq.dispatch()
+	})
+}
+
+// Stop stops dispatcher and waits for running tasks to finish
+func (eq *EmbeddedQueue) Stop() {
+	eq.stopOnce.Do(func() {
+		close(eq.quit)
+		eq.wg.Wait()
+	})
+}
+
+// QueuedTasks returns the number of pending tasks, including the ones scheduled for a retry
+func (eq *EmbeddedQueue) QueuedTasks() (int, error) {
+	iter := eq.db.NewIterator(leveldbUtil.BytesPrefix([]byte(pendingTaskPrefix)), nil)
+	defer iter.Release()
+
+	count := 0
+	for iter.Next() {
+		count++
+	}
+
+	return count, iter.Error()
+}
+
+// dispatch hands due tasks to the workers
+func (eq *EmbeddedQueue) dispatch() {
+	defer eq.wg.Done()
+
+	ticker := time.NewTicker(embeddedQueuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		if !eq.dispatchDue() {
+			return
+		}
+
+		select {
+		case <-eq.quit:
+			return
+		case <-eq.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchDue moves all tasks whose ETA has passed to in-flight and sends them
+// to workers. It returns false if the queue was stopped meanwhile.
+func (eq *EmbeddedQueue) dispatchDue() bool {
+	iter := eq.db.NewIterator(leveldbUtil.BytesPrefix([]byte(pendingTaskPrefix)), nil)
+	defer iter.Release()
+
+	now := time.Now()
+
+	for iter.Next() {
+		// keys are sorted by ETA, nothing more is due
+		if etaFromPendingKey(iter.Key()).After(now) {
+			break
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			eq.logger.Error("Dropping malformed task", "key", string(iter.Key()), "error", err)
+
+			if err := eq.db.Delete(iter.Key(), nil); err != nil {
+				eq.logger.Error("Error while deleting malformed task", "error", err)
+			}
+
+			continue
+		}
+
+		batch := new(leveldb.Batch)
+		batch.Delete(iter.Key())
+		batch.Put(inFlightTaskKey(signature.UUID), iter.Value())
+
+		if err := eq.db.Write(batch, nil); err != nil {
+			eq.logger.Error("Error while marking task as in-flight", "taskName", signature.Name, "error", err)
+			continue
+		}
+
+		select {
+		case eq.jobs <- signature:
+		case <-eq.quit:
+			// task remains in flight and will be requeued on next start
+			return false
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		eq.logger.Error("Error while iterating pending tasks", "error", err)
+	}
+
+	return true
+}
+
+// work processes tasks until the queue is stopped
+func (eq *EmbeddedQueue) work() {
+	defer eq.wg.Done()
+
+	for {
+		select {
+		case signature := <-eq.jobs:
+			eq.process(signature)
+		case <-eq.quit:
+			return
+		}
+	}
+}
+
+// process runs the task and applies the machinery retry semantics
+func (eq *EmbeddedQueue) process(signature *tasks.Signature) {
+	eq.handlersMu.RLock()
+	taskFunc, ok := eq.handlers[signature.Name]
+	eq.handlersMu.RUnlock()
+
+	if !ok {
+		// processor not registered (yet), keep the task around like the amqp broker does
+		eq.retryIn(signature, unregisteredTaskDelay)
+		return
+	}
+
+	incrementAttempts(signature)
+
+	task, err := tasks.NewWithSignature(taskFunc, signature)
+	if err != nil {
+		eq.failed(signature, err)
+		return
+	}
+
+	if _, err = task.Call(); err != nil {
+		if retriableErr, ok := interface{}(err).(tasks.ErrRetryTaskLater); ok {
+			eq.retryIn(signature, retriableErr.RetryIn())
+			return
+		}
+
+		if signature.RetryCount > 0 {
+			signature.RetryCount--
+			signature.RetryTimeout = retry.FibonacciNext(signature.RetryTimeout)
+
+			eq.logger.Info("Task failed, going to retry", "taskName", signature.Name, "uuid", signature.UUID, "retryIn", signature.RetryTimeout, "error", err)
+			eq.retryIn(signature, time.Duration(signature.RetryTimeout)*time.Second)
+
+			return
+		}
+
+		eq.failed(signature, err)
+
+		return
+	}
+
+	eq.done(signature)
+}
+
+// retryIn puts the task back in the queue with ETA of now + delay
+func (eq *EmbeddedQueue) retryIn(signature *tasks.Signature, delay time.Duration) {
+	eta := time.Now().Add(delay)
+	signature.ETA = &eta
+
+	value, err := json.Marshal(signature)
+	if err != nil {
+		eq.logger.Error("Error while marshalling task for retry", "taskName", signature.Name, "error", err)
+		return
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Delete(inFlightTaskKey(signature.UUID))
+	batch.Put(pendingTaskKey(eta, signature.UUID), value)
+
+	if err := eq.db.Write(batch, nil); err != nil {
+		eq.logger.Error("Error while requeuing task", "taskName", signature.Name, "error", err)
+	}
+}
+
+// failed moves a task which exhausted its retries to the dead letter store
+func (eq *EmbeddedQueue) failed(signature *tasks.Signature, taskErr error) {
+	eq.logger.Error("Task failed", "taskName", signature.Name, "uuid", signature.UUID, "error", taskErr)
+
+	if err := eq.deadLetters.Add(signature, taskAttempts(signature), taskErr); err != nil {
+		eq.logger.Error("Error while recording failed task", "taskName", signature.Name, "error", err)
+	}
+
+	eq.done(signature)
+}
+
+// done removes the task from in-flight
+func (eq *EmbeddedQueue) done(signature *tasks.Signature) {
+	if err := eq.db.Delete(inFlightTaskKey(signature.UUID), nil); err != nil {
+		eq.logger.Error("Error while deleting in-flight task", "taskName", signature.Name, "error", err)
+	}
+}
+
+// requeueInFlight moves tasks interrupted by a shutdown back to pending
+func (eq *EmbeddedQueue) requeueInFlight() error {
+	iter := eq.db.NewIterator(leveldbUtil.BytesPrefix([]byte(inFlightTaskPrefix)), nil)
+	defer iter.Release()
+
+	now := time.Now()
+	batch := new(leveldb.Batch)
+
+	for iter.Next() {
+		taskUUID := iter.Key()[len(inFlightTaskPrefix):]
+
+		batch.Delete(iter.Key())
+		batch.Put(pendingTaskKey(now, string(taskUUID)), iter.Value())
+	}
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if batch.Len() > 0 {
+		eq.logger.Info("Requeuing in-flight tasks", "count", batch.Len()/2)
+	}
+
+	return eq.db.Write(batch, nil)
+}
+
+// PurgeEmbeddedQueue removes all pending and in-flight tasks from db
+func PurgeEmbeddedQueue(db *leveldb.DB) (int, error) {
+	batch := new(leveldb.Batch)
+
+	for _, prefix := range []string{pendingTaskPrefix, inFlightTaskPrefix} {
+		iter := db.NewIterator(leveldbUtil.BytesPrefix([]byte(prefix)), nil)
+		for iter.Next() {
+			batch.Delete(iter.Key())
+		}
+
+		iter.Release()
+
+		if err := iter.Error(); err != nil {
+			return 0, err
+		}
+	}
+
+	return batch.Len(), db.Write(batch, nil)
+}
+
+// decodeSignature unmarshals a stored task keeping numbers as json.Number,
+// the same way machinery's amqp broker does, so int64 args survive the roundtrip
+func decodeSignature(value []byte) (*tasks.Signature, error) {
+	signature := new(tasks.Signature)
+
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func pendingTaskKey(eta time.Time, taskUUID string) []byte {
+	key := make([]byte, 0, len(pendingTaskPrefix)+8+len(taskUUID))
+	key = append(key, pendingTaskPrefix...)
+	//nolint:gosec
+	key = binary.BigEndian.AppendUint64(key, uint64(eta.UnixNano()))
+
+	return append(key, taskUUID...)
+}
+
+func etaFromPendingKey(key []byte) time.Time {
+	nanos := binary.BigEndian.Uint64(key[len(pendingTaskPrefix) : len(pendingTaskPrefix)+8])
+
+	//nolint:gosec
+	return time.Unix(0, int64(nanos))
+}
+
+func inFlightTaskKey(taskUUID string) []byte {
+	return append([]byte(inFlightTaskPrefix), taskUUID...)
+}
@@ -0,0 +1,200 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func newTestSignature(name string, args ...tasks.Arg) *tasks.Signature {
+	return &tasks.Signature{
+		Name: name,
+		Args: args,
+	}
+}
+
+func TestEmbeddedQueueProcessesTask(t *testing.T) {
+	t.Parallel()
+
+	eq := NewEmbeddedQueue(newTestDB(t))
+	defer eq.Stop()
+
+	type call struct {
+		payload string
+		height  int64
+	}
+
+	calls := make(chan call, 1)
+
+	require.NoError(t, eq.RegisterTask("sendBlockTask", func(payload string, height int64) error {
+		calls <- call{payload, height}
+		return nil
+	}))
+
+	eq.StartWorker()
+
+	require.NoError(t, eq.SendTask(newTestSignature("sendBlockTask",
+		tasks.Arg{Type: "string", Value: "event"},
+		tasks.Arg{Type: "int64", Value: int64(1 << 60)},
+	)))
+
+	select {
+	case c := <-calls:
+		require.Equal(t, "event", c.payload)
+		require.Equal(t, int64(1<<60), c.height)
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+}
+
+func TestEmbeddedQueueHonoursETA(t *testing.T) {
+	t.Parallel()
+
+	eq := NewEmbeddedQueue(newTestDB(t))
+	defer eq.Stop()
+
+	processed := make(chan time.Time, 1)
+
+	require.NoError(t, eq.RegisterTask("delayed", func() error {
+		processed <- time.Now()
+		return nil
+	}))
+
+	eq.StartWorker()
+
+	eta := time.Now().Add(1500 * time.Millisecond)
+	signature := newTestSignature("delayed")
+	signature.ETA = &eta
+
+	require.NoError(t, eq.SendTask(signature))
+
+	select {
+	case at := <-processed:
+		require.False(t, at.Before(eta))
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+}
+
+func TestEmbeddedQueueRetries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retry count", func(t *testing.T) {
+		t.Parallel()
+
+		eq := NewEmbeddedQueue(newTestDB(t))
+		defer eq.Stop()
+
+		attempts := make(chan struct{}, 10)
+
+		require.NoError(t, eq.RegisterTask("flaky", func() error {
+			attempts <- struct{}{}
+			if len(attempts) < 2 {
+				return errors.New("bad")
+			}
+
+			return nil
+		}))
+
+		eq.StartWorker()
+
+		signature := newTestSignature("flaky")
+		signature.RetryCount = 3
+
+		require.NoError(t, eq.SendTask(signature))
+
+		require.Eventually(t, func() bool { return len(attempts) == 2 }, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("retry later", func(t *testing.T) {
+		t.Parallel()
+
+		eq := NewEmbeddedQueue(newTestDB(t))
+		defer eq.Stop()
+
+		attempts := make(chan struct{}, 10)
+
+		require.NoError(t, eq.RegisterTask("later", func() error {
+			attempts <- struct{}{}
+			if len(attempts) < 3 {
+				return tasks.NewErrRetryTaskLater("not yet", 100*time.Millisecond)
+			}
+
+			return nil
+		}))
+
+		eq.StartWorker()
+
+		require.NoError(t, eq.SendTask(newTestSignature("later")))
+
+		require.Eventually(t, func() bool { return len(attempts) == 3 }, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+func TestEmbeddedQueueRequeuesInFlight(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+
+	// simulate a task which was being processed when the bridge stopped
+	value := []byte(`{"UUID":"task_1","Name":"interrupted","Args":[]}`)
+	require.NoError(t, db.Put(inFlightTaskKey("task_1"), value, nil))
+
+	eq := NewEmbeddedQueue(db)
+	defer eq.Stop()
+
+	processed := make(chan struct{}, 1)
+
+	require.NoError(t, eq.RegisterTask("interrupted", func() error {
+		processed <- struct{}{}
+		return nil
+	}))
+
+	eq.StartWorker()
+
+	select {
+	case <-processed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight task was not requeued")
+	}
+}
+
+func TestPurgeEmbeddedQueue(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	eq := NewEmbeddedQueue(db)
+
+	eta := time.Now().Add(time.Hour)
+
+	for i := 0; i < 3; i++ {
+		signature := newTestSignature("pending")
+		signature.ETA = &eta
+
+		require.NoError(t, eq.SendTask(signature))
+	}
+
+	count, err := PurgeEmbeddedQueue(db)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	count, err = PurgeEmbeddedQueue(db)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
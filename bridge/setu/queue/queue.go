@@ -0,0 +1,52 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// AMQPBackend runs the bridge tasks through a RabbitMQ broker using machinery
+	AMQPBackend = "amqp"
+
+	// EmbeddedBackend runs the bridge tasks through the bridge leveldb
+	EmbeddedBackend = "embedded"
+)
+
+// TaskQueue hands tasks from listeners to processors
+type TaskQueue interface {
+	// RegisterTask registers a task handler with the queue, the handler follows
+	// machinery conventions (string/int args, error as last return value)
+	RegisterTask(name string, taskFunc interface{}) error
+
+	// SendTask enqueues a task, honouring signature ETA and RetryCount
+	SendTask(signature *tasks.Signature) error
+
+	// StartWorker starts processing the registered tasks
+	StartWorker()
+
+	// Stop stops processing tasks
+	Stop()
+
+	// QueuedTasks returns the number of tasks waiting to be processed
+	QueuedTasks() (int, error)
+}
+
+// NewTaskQueue returns the task queue backend selected in heimdall config
+func NewTaskQueue(config helper.Configuration) (TaskQueue, error) {
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
+	switch config.TaskQueueBackend {
+	case "", AMQPBackend:
+		return NewQueueConnector(config.AmqpURL, NewDeadLetterStore(db))
+	case EmbeddedBackend:
+		return NewEmbeddedQueue(db), nil
+	default:
+		return nil, fmt.Errorf("unknown task queue backend %q, valid values are %q and %q", config.TaskQueueBackend, AMQPBackend, EmbeddedBackend)
+	}
+}
@@ -0,0 +1,146 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/viper"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	statusServerStr = "status-server"
+
+	// statusTimeout bounds the time spent collecting the status
+	statusTimeout = 5 * time.Second
+)
+
+// Server serves the bridge status as json on /status
+type Server struct {
+	// Base service
+	cmn.BaseService
+
+	addr     string
+	reporter *reporter
+	server   *http.Server
+}
+
+// NewServer creates the bridge status server listening on the configured bridge status address
+func NewServer(cdc *codec.Codec, taskQueue queue.TaskQueue, client *httpClient.HTTP, rootchainTxManager *broadcaster.RootchainTxManager) *Server {
+	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+	cliCtx.TrustNode = true
+
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
+	s := &Server{
+		addr: helper.GetConfig().BridgeStatusAddr,
+		reporter: &reporter{
+			cliCtx:      cliCtx,
+			db:          db,
+			taskQueue:   taskQueue,
+			backend:     helper.GetConfig().TaskQueueBackend,
+			deadLetters: queue.NewDeadLetterStore(db),
+			cursors: []listenerCursor{
+				rootchainCursor(rootchainHead),
+				heimdallCursor(func(_ context.Context) (uint64, error) {
+					return heimdallHead(client)
+				}),
+			},
+			rootchainTxs: rootchainTxManager.Transactions,
+		},
+	}
+
+	s.BaseService = *cmn.NewBaseService(util.Logger().With("module", statusServerStr), statusServerStr, s)
+
+	return s
+}
+
+// OnStart starts serving the status
+func (s *Server) OnStart() error {
+	if err := s.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", s.handleStatus)
+
+	cfg := rpcserver.DefaultConfig()
+
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+
+	go func() {
+		if err := s.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.Logger.Error("Bridge status server stopped", "error", err)
+		}
+	}()
+
+	s.Logger.Info("Serving bridge status", "addr", s.addr)
+
+	return nil
+}
+
+// OnStop stops serving the status
+func (s *Server) OnStop() {
+	s.BaseService.OnStop()
+
+	if s.server != nil {
+		if err := s.server.Close(); err != nil {
+			s.Logger.Error("Error closing bridge status server", "error", err)
+		}
+	}
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), statusTimeout)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(s.reporter.report(ctx)); err != nil {
+		s.Logger.Error("Error writing bridge status", "error", err)
+	}
+}
+
+// rootchainHead returns the latest rootchain block
+func rootchainHead(ctx context.Context) (uint64, error) {
+	header, err := helper.GetMainClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// heimdallHead returns the latest heimdall block
+func heimdallHead(client *httpClient.HTTP) (uint64, error) {
+	status, err := client.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(status.SyncInfo.LatestBlockHeight), nil
+}
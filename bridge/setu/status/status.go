@@ -0,0 +1,269 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// Status is the bridge status served by the status api
+type Status struct {
+	Listeners  []ListenerStatus            `json:"listeners"`
+	Queue      QueueStatus                 `json:"queue"`
+	Processors map[string]processor.Health `json:"processors"`
+	Proposer   ProposerStatus              `json:"proposer"`
+	Rootchain  RootchainTxsStatus          `json:"rootchain"`
+}
+
+// ListenerStatus is the last block processed by a listener compared to the chain head
+type ListenerStatus struct {
+	Name      string `json:"name"`
+	LastBlock uint64 `json:"lastBlock"`
+	Head      uint64 `json:"head"`
+	// blocks between the chain head and the last processed block, including the required confirmations on the root chain
+	Lag   uint64 `json:"lag"`
+	Error string `json:"error,omitempty"`
+}
+
+// QueueStatus is the state of the bridge task queue
+type QueueStatus struct {
+	Backend  string `json:"backend"`
+	Queued   int    `json:"queued"`
+	InFlight int    `json:"inFlight"`
+	// tasks which exhausted their retries, see `bridge tasks list`
+	Failed int    `json:"failed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ProposerStatus tells which proposer roles this node currently holds
+type ProposerStatus struct {
+	Checkpoint        bool   `json:"checkpoint"`
+	CurrentCheckpoint bool   `json:"currentCheckpoint"`
+	Milestone         bool   `json:"milestone"`
+	Span              bool   `json:"span"`
+	Error             string `json:"error,omitempty"`
+}
+
+// RootchainTxsStatus is the status of the transactions sent to the root chain, kept a week once finished
+type RootchainTxsStatus struct {
+	Pending      int                 `json:"pending"`
+	Transactions []RootchainTxStatus `json:"transactions"`
+	Error        string              `json:"error,omitempty"`
+}
+
+// RootchainTxStatus is the status of a transaction sent to the root chain
+type RootchainTxStatus struct {
+	Nonce       uint64 `json:"nonce"`
+	Description string `json:"description"`
+	// pending, success, reverted or replaced
+	Status string `json:"status"`
+	// hash of the mined version, of the last sent version while pending
+	TxHash common.Hash `json:"txHash"`
+	// versions replaced with higher fees
+	Replacements int       `json:"replacements"`
+	BlockNumber  uint64    `json:"blockNumber,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	FinishedAt   time.Time `json:"finishedAt,omitempty"`
+}
+
+// headFn returns the latest block of a chain
+type headFn func(ctx context.Context) (uint64, error)
+
+// listenerCursor is a listener last block stored in the bridge db and the head of its chain
+type listenerCursor struct {
+	name string
+	key  string
+	head headFn
+}
+
+// reporter collects the bridge status
+type reporter struct {
+	cliCtx      cliContext.CLIContext
+	db          *leveldb.DB
+	taskQueue   queue.TaskQueue
+	backend     string
+	deadLetters *queue.DeadLetterStore
+	cursors     []listenerCursor
+
+	// rootchainTxs returns the transactions sent to the root chain, nil if the bridge sends none
+	rootchainTxs func() ([]*broadcaster.RootchainTx, error)
+}
+
+// report returns the current bridge status, failing sections carry their error
+func (r *reporter) report(ctx context.Context) Status {
+	return Status{
+		Listeners:  r.listeners(ctx),
+		Queue:      r.taskQueueStatus(),
+		Processors: processor.ProcessorsHealth(),
+		Proposer:   r.proposer(),
+		Rootchain:  r.rootchain(),
+	}
+}
+
+func (r *reporter) listeners(ctx context.Context) []ListenerStatus {
+	result := make([]ListenerStatus, 0, len(r.cursors))
+
+	for _, cursor := range r.cursors {
+		listenerStatus := ListenerStatus{Name: cursor.name}
+
+		lastBlock, err := r.lastBlock(cursor.key)
+		if err != nil {
+			listenerStatus.Error = err.Error()
+			result = append(result, listenerStatus)
+
+			continue
+		}
+
+		listenerStatus.LastBlock = lastBlock
+
+		head, err := cursor.head(ctx)
+		if err != nil {
+			listenerStatus.Error = err.Error()
+			result = append(result, listenerStatus)
+
+			continue
+		}
+
+		listenerStatus.Head = head
+
+		if head > lastBlock {
+			listenerStatus.Lag = head - lastBlock
+		}
+
+		result = append(result, listenerStatus)
+	}
+
+	return result
+}
+
+// lastBlock returns the block stored by a listener under key, 0 if it did not process any block yet
+func (r *reporter) lastBlock(key string) (uint64, error) {
+	value, err := r.db.Get([]byte(key), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(value), 10, 64)
+}
+
+func (r *reporter) taskQueueStatus() QueueStatus {
+	queueStatus := QueueStatus{Backend: r.backend}
+
+	for _, health := range processor.ProcessorsHealth() {
+		queueStatus.InFlight += health.InFlight
+	}
+
+	failedTasks, err := r.deadLetters.List()
+	if err != nil {
+		queueStatus.Error = err.Error()
+		return queueStatus
+	}
+
+	queueStatus.Failed = len(failedTasks)
+
+	queued, err := r.taskQueue.QueuedTasks()
+	if err != nil {
+		queueStatus.Error = err.Error()
+		return queueStatus
+	}
+
+	queueStatus.Queued = queued
+
+	return queueStatus
+}
+
+func (r *reporter) proposer() ProposerStatus {
+	var (
+		proposerStatus ProposerStatus
+		err            error
+	)
+
+	if proposerStatus.Checkpoint, err = util.IsProposer(r.cliCtx); err != nil {
+		proposerStatus.Error = err.Error()
+		return proposerStatus
+	}
+
+	if proposerStatus.CurrentCheckpoint, err = util.IsCurrentProposer(r.cliCtx); err != nil {
+		proposerStatus.Error = err.Error()
+		return proposerStatus
+	}
+
+	if proposerStatus.Milestone, err = util.IsMilestoneProposer(r.cliCtx); err != nil {
+		proposerStatus.Error = err.Error()
+		return proposerStatus
+	}
+
+	if proposerStatus.Span, err = util.IsSpanProducer(r.cliCtx); err != nil {
+		proposerStatus.Error = err.Error()
+		return proposerStatus
+	}
+
+	return proposerStatus
+}
+
+func (r *reporter) rootchain() RootchainTxsStatus {
+	rootchainStatus := RootchainTxsStatus{Transactions: []RootchainTxStatus{}}
+
+	if r.rootchainTxs == nil {
+		return rootchainStatus
+	}
+
+	txs, err := r.rootchainTxs()
+	if err != nil {
+		rootchainStatus.Error = err.Error()
+		return rootchainStatus
+	}
+
+	for _, tx := range txs {
+		txStatus := RootchainTxStatus{
+			Nonce:       tx.Nonce,
+			Description: tx.Description,
+			Status:      tx.Status,
+			TxHash:      tx.TxHash,
+			BlockNumber: tx.BlockNumber,
+			CreatedAt:   tx.CreatedAt,
+			FinishedAt:  tx.FinishedAt,
+		}
+
+		if len(tx.Hashes) > 0 {
+			txStatus.Replacements = len(tx.Hashes) - 1
+
+			if tx.Status == broadcaster.RootchainTxPending {
+				txStatus.TxHash = tx.Hashes[len(tx.Hashes)-1]
+			}
+		}
+
+		if tx.Status == broadcaster.RootchainTxPending {
+			rootchainStatus.Pending++
+		}
+
+		rootchainStatus.Transactions = append(rootchainStatus.Transactions, txStatus)
+	}
+
+	return rootchainStatus
+}
+
+// rootchainCursor returns the rootchain listener cursor
+func rootchainCursor(head headFn) listenerCursor {
+	return listenerCursor{name: "rootchain", key: listener.LastRootBlockKey, head: head}
+}
+
+// heimdallCursor returns the heimdall listener cursor
+func heimdallCursor(head headFn) listenerCursor {
+	return listenerCursor{name: "heimdall", key: listener.HeimdallLastBlockKey, head: head}
+}
@@ -0,0 +1,145 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+)
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	t.Helper()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func fixedHead(head uint64, err error) headFn {
+	return func(_ context.Context) (uint64, error) {
+		return head, err
+	}
+}
+
+func TestListenersStatus(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	require.NoError(t, db.Put([]byte(listener.LastRootBlockKey), []byte("100"), nil))
+
+	r := &reporter{
+		db: db,
+		cursors: []listenerCursor{
+			rootchainCursor(fixedHead(112, nil)),
+			heimdallCursor(fixedHead(0, errors.New("node down"))),
+		},
+	}
+
+	listeners := r.listeners(context.Background())
+	require.Equal(t, []ListenerStatus{
+		{Name: "rootchain", LastBlock: 100, Head: 112, Lag: 12},
+		{Name: "heimdall", Error: "node down"},
+	}, listeners)
+}
+
+func TestTaskQueueStatus(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+
+	taskQueue := queue.NewEmbeddedQueue(db)
+	defer taskQueue.Stop()
+
+	require.NoError(t, taskQueue.SendTask(&tasks.Signature{Name: "sendTask"}))
+	require.NoError(t, taskQueue.SendTask(&tasks.Signature{Name: "sendTask"}))
+
+	deadLetters := queue.NewDeadLetterStore(db)
+	require.NoError(t, deadLetters.Add(&tasks.Signature{UUID: "failed", Name: "sendTask"}, 3, errors.New("failed")))
+
+	r := &reporter{
+		db:          db,
+		taskQueue:   taskQueue,
+		backend:     queue.EmbeddedBackend,
+		deadLetters: deadLetters,
+	}
+
+	queueStatus := r.taskQueueStatus()
+	require.Empty(t, queueStatus.Error)
+	require.Equal(t, queue.EmbeddedBackend, queueStatus.Backend)
+	require.Equal(t, 2, queueStatus.Queued)
+	require.Equal(t, 1, queueStatus.Failed)
+}
+
+func TestRootchainTxsStatus(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Unix(1000, 0)
+	finishedAt := time.Unix(2000, 0)
+
+	r := &reporter{
+		rootchainTxs: func() ([]*broadcaster.RootchainTx, error) {
+			return []*broadcaster.RootchainTx{
+				{
+					Nonce:       1,
+					Description: "checkpoint",
+					Hashes:      []common.Hash{{0x1}, {0x2}},
+					Status:      broadcaster.RootchainTxSuccess,
+					TxHash:      common.Hash{0x1},
+					BlockNumber: 100,
+					CreatedAt:   createdAt,
+					FinishedAt:  finishedAt,
+				},
+				{
+					Nonce:       2,
+					Description: "slashing tick",
+					Hashes:      []common.Hash{{0x3}, {0x4}, {0x5}},
+					Status:      broadcaster.RootchainTxPending,
+					CreatedAt:   createdAt,
+				},
+			}, nil
+		},
+	}
+
+	require.Equal(t, RootchainTxsStatus{
+		Pending: 1,
+		Transactions: []RootchainTxStatus{
+			{
+				Nonce:        1,
+				Description:  "checkpoint",
+				Status:       broadcaster.RootchainTxSuccess,
+				TxHash:       common.Hash{0x1},
+				Replacements: 1,
+				BlockNumber:  100,
+				CreatedAt:    createdAt,
+				FinishedAt:   finishedAt,
+			},
+			{
+				Nonce:        2,
+				Description:  "slashing tick",
+				Status:       broadcaster.RootchainTxPending,
+				TxHash:       common.Hash{0x5},
+				Replacements: 2,
+				CreatedAt:    createdAt,
+			},
+		},
+	}, r.rootchain())
+
+	r.rootchainTxs = func() ([]*broadcaster.RootchainTx, error) { return nil, errors.New("db closed") }
+	require.Equal(t, RootchainTxsStatus{Transactions: []RootchainTxStatus{}, Error: "db closed"}, r.rootchain())
+
+	// a bridge without rootchain transactions
+	require.Equal(t, RootchainTxsStatus{Transactions: []RootchainTxStatus{}}, (&reporter{}).rootchain())
+}
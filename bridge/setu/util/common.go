@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -28,6 +27,7 @@ import (
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	milestoneTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	clerktypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/client/restclient"
 	"github.com/maticnetwork/heimdall/contracts/statesender"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
@@ -120,25 +120,12 @@ func Logger() log.Logger {
 
 // IsProposer  checks if we are proposer
 func IsProposer(cliCtx cliContext.CLIContext) (bool, error) {
-	var (
-		proposers []hmtypes.Validator
-		count     = uint64(1)
-	)
-
-	result, err := helper.FetchFromAPI(cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count, 10))),
-	)
+	proposers, _, err := restclient.New(cliCtx).GetProposers(1)
 	if err != nil {
 		logger.Error("Error fetching proposers", "url", ProposersURL, "error", err)
 		return false, err
 	}
 
-	err = jsoniter.ConfigFastest.Unmarshal(result.Result, &proposers)
-	if err != nil {
-		logger.Error("error unmarshalling proposer slice", "error", err)
-		return false, err
-	}
-
 	if bytes.Equal(proposers[0].Signer.Bytes(), helper.GetAddress()) {
 		return true, nil
 	}
@@ -147,25 +134,12 @@ func IsProposer(cliCtx cliContext.CLIContext) (bool, error) {
 }
 
 func IsMilestoneProposer(cliCtx cliContext.CLIContext) (bool, error) {
-	var (
-		proposers []hmtypes.Validator
-		count     = uint64(1)
-	)
-
-	result, err := helper.FetchFromAPI(cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(MilestoneProposersURL, strconv.FormatUint(count, 10))),
-	)
+	proposers, _, err := restclient.New(cliCtx).GetMilestoneProposers(1)
 	if err != nil {
 		logger.Error("Error fetching milestone proposers", "url", MilestoneProposersURL, "error", err)
 		return false, err
 	}
 
-	err = jsoniter.ConfigFastest.Unmarshal(result.Result, &proposers)
-	if err != nil {
-		logger.Error("error unmarshalling milestone proposer slice", "error", err)
-		return false, err
-	}
-
 	if len(proposers) == 0 {
 		logger.Error("length of proposer list is 0")
 		return false, errors.Errorf("Length of proposer list is 0")
@@ -182,22 +156,12 @@ func IsMilestoneProposer(cliCtx cliContext.CLIContext) (bool, error) {
 func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error) {
 	logger.Debug("Skipping proposers", "count", strconv.FormatUint(count+1, 10))
 
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count+1, 10))),
-	)
+	proposers, _, err := restclient.New(cliCtx).GetProposers(count + 1)
 	if err != nil {
 		logger.Error("Unable to send request for next proposers", "url", ProposersURL, "error", err)
 		return false, err
 	}
 
-	// unmarshall data from buffer
-	var proposers []hmtypes.Validator
-	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &proposers); err != nil {
-		logger.Error("Error unmarshalling validator data ", "error", err)
-		return false, err
-	}
-
 	logger.Debug("Fetched proposers list", "numberOfProposers", count+1)
 
 	if count > math.MaxInt {
@@ -216,22 +180,12 @@ func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error)
 func IsInMilestoneProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error) {
 	logger.Debug("Skipping proposers", "count", strconv.FormatUint(count, 10))
 
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(MilestoneProposersURL, strconv.FormatUint(count, 10))),
-	)
+	proposers, _, err := restclient.New(cliCtx).GetMilestoneProposers(count)
 	if err != nil {
 		logger.Error("Unable to send request for next proposers", "url", MilestoneProposersURL, "error", err)
 		return false, err
 	}
 
-	// unmarshall data from buffer
-	var proposers []hmtypes.Validator
-	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &proposers); err != nil {
-		logger.Error("Error unmarshalling validator data ", "error", err)
-		return false, err
-	}
-
 	logger.Debug("Fetched proposers list", "numberOfProposers", count)
 
 	for _, proposer := range proposers {
@@ -286,19 +240,12 @@ func CalculateTaskDelay(cliCtx cliContext.CLIContext, event interface{}) (bool,
 
 // IsCurrentProposer checks if we are current proposer
 func IsCurrentProposer(cliCtx cliContext.CLIContext) (bool, error) {
-	var proposer hmtypes.Validator
-
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(CurrentProposerURL))
+	proposer, _, err := restclient.New(cliCtx).GetCurrentProposer()
 	if err != nil {
 		logger.Error("Error fetching proposers", "error", err)
 		return false, err
 	}
 
-	if err = jsoniter.ConfigFastest.Unmarshal(result.Result, &proposer); err != nil {
-		logger.Error("error unmarshalling validator", "error", err)
-		return false, err
-	}
-
 	logger.Debug("Current proposer fetched", "validator", proposer.String())
 
 	if bytes.Equal(proposer.Signer.Bytes(), helper.GetAddress()) {
@@ -310,23 +257,31 @@ func IsCurrentProposer(cliCtx cliContext.CLIContext) (bool, error) {
 	return false, nil
 }
 
+// IsSpanProducer checks if we are among the producers of the latest span
+func IsSpanProducer(cliCtx cliContext.CLIContext) (bool, error) {
+	span, _, err := restclient.New(cliCtx).GetLatestSpan()
+	if err != nil {
+		logger.Error("Error fetching latest span", "error", err)
+		return false, err
+	}
+
+	for _, producer := range span.SelectedProducers {
+		if bytes.Equal(producer.Signer.Bytes(), helper.GetAddress()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // IsEventSender check if we are the EventSender
 func IsEventSender(cliCtx cliContext.CLIContext, validatorID uint64) bool {
-	var validator hmtypes.Validator
-
-	result, err := helper.FetchFromAPI(cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorURL, strconv.FormatUint(validatorID, 10))),
-	)
+	validator, _, err := restclient.New(cliCtx).GetValidator(validatorID)
 	if err != nil {
 		logger.Error("Error fetching proposers", "error", err)
 		return false
 	}
 
-	if err = jsoniter.ConfigFastest.Unmarshal(result.Result, &validator); err != nil {
-		logger.Error("error unmarshalling proposer slice", "error", err)
-		return false
-	}
-
 	logger.Debug("Current event sender received", "validator", validator.String())
 
 	return bytes.Equal(validator.Signer.Bytes(), helper.GetAddress())
@@ -399,16 +354,10 @@ func IsCatchingUp(cliCtx cliContext.CLIContext) bool {
 
 // GetAccount returns heimdall auth account
 func GetAccount(cliCtx cliContext.CLIContext, address types.HeimdallAddress) (account authTypes.Account, err error) {
-	url := helper.GetHeimdallServerEndpoint(fmt.Sprintf(AccountDetailsURL, address))
-
 	// call account rest api
-	response, err := helper.FetchFromAPI(cliCtx, url)
+	account, _, err = restclient.New(cliCtx).GetAccount(address.String())
 	if err != nil {
-		return
-	}
-
-	if err = cliCtx.Codec.UnmarshalJSON(response.Result, &account); err != nil {
-		logger.Error("Error unmarshalling account details", "url", url)
+		logger.Error("Error fetching account details", "url", fmt.Sprintf(AccountDetailsURL, address), "error", err)
 		return
 	}
 
@@ -417,18 +366,9 @@ func GetAccount(cliCtx cliContext.CLIContext, address types.HeimdallAddress) (ac
 
 // GetChainmanagerParams return chain manager params
 func GetChainmanagerParams(cliCtx cliContext.CLIContext) (*chainManagerTypes.Params, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(ChainManagerParamsURL),
-	)
+	params, _, err := restclient.New(cliCtx).GetChainmanagerParams()
 	if err != nil {
-		logger.Error("Error fetching chainmanager params", "err", err)
-		return nil, err
-	}
-
-	var params chainManagerTypes.Params
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &params); err != nil {
-		logger.Error("Error unmarshalling chainmanager params", "url", ChainManagerParamsURL, "err", err)
+		logger.Error("Error fetching chainmanager params", "url", ChainManagerParamsURL, "err", err)
 		return nil, err
 	}
 
@@ -437,40 +377,20 @@ func GetChainmanagerParams(cliCtx cliContext.CLIContext) (*chainManagerTypes.Par
 
 // GetCheckpointParams return params
 func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(CheckpointParamsURL),
-	)
-
+	params, _, err := restclient.New(cliCtx).GetCheckpointParams()
 	if err != nil {
-		logger.Error("Error fetching Checkpoint params", "err", err)
-		return nil, err
-	}
-
-	var params checkpointTypes.Params
-	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &params); err != nil {
-		logger.Error("Error unmarshalling Checkpoint params", "url", CheckpointParamsURL)
+		logger.Error("Error fetching Checkpoint params", "url", CheckpointParamsURL, "err", err)
 		return nil, err
 	}
 
 	return &params, nil
 }
 
-// GetMilestoneParams return params
-func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.Params, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(MilestoneParamsURL),
-	)
-
+// GetMilestoneParams return the milestone params in effect
+func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.MilestoneParams, error) {
+	params, _, err := restclient.New(cliCtx).GetMilestoneParams()
 	if err != nil {
-		logger.Error("Error fetching Milestone params", "err", err)
-		return nil, err
-	}
-
-	var params milestoneTypes.Params
-	if err := json.Unmarshal(response.Result, &params); err != nil {
-		logger.Error("Error unmarshalling Checkpoint params", "url", MilestoneParamsURL)
+		logger.Error("Error fetching Milestone params", "url", MilestoneParamsURL, "err", err)
 		return nil, err
 	}
 
@@ -479,19 +399,9 @@ func GetMilestoneParams(cliCtx cliContext.CLIContext) (*milestoneTypes.Params, e
 
 // GetBufferedCheckpoint return checkpoint from bueffer
 func GetBufferedCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(BufferedCheckpointURL),
-	)
-
+	checkpoint, _, err := restclient.New(cliCtx).GetBufferedCheckpoint()
 	if err != nil {
-		logger.Debug("Error fetching buffered checkpoint", "err", err)
-		return nil, err
-	}
-
-	var checkpoint hmtypes.Checkpoint
-	if err := jsoniter.ConfigFastest.Unmarshal(response.Result, &checkpoint); err != nil {
-		logger.Error("Error unmarshalling buffered checkpoint", "url", BufferedCheckpointURL, "err", err)
+		logger.Debug("Error fetching buffered checkpoint", "url", BufferedCheckpointURL, "err", err)
 		return nil, err
 	}
 
@@ -500,19 +410,9 @@ func GetBufferedCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, e
 
 // GetLatestCheckpoint return last successful checkpoint
 func GetLatestCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(LatestCheckpointURL),
-	)
-
+	checkpoint, _, err := restclient.New(cliCtx).GetLatestCheckpoint()
 	if err != nil {
-		logger.Debug("Error fetching latest checkpoint", "err", err)
-		return nil, err
-	}
-
-	var checkpoint hmtypes.Checkpoint
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &checkpoint); err != nil {
-		logger.Error("Error unmarshalling latest checkpoint", "url", LatestCheckpointURL, "err", err)
+		logger.Debug("Error fetching latest checkpoint", "url", LatestCheckpointURL, "err", err)
 		return nil, err
 	}
 
@@ -521,19 +421,9 @@ func GetLatestCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, err
 
 // GetLatestMilestone return last successful milestone
 func GetLatestMilestone(cliCtx cliContext.CLIContext) (*hmtypes.Milestone, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(LatestMilestoneURL),
-	)
-
+	milestone, _, err := restclient.New(cliCtx).GetLatestMilestone()
 	if err != nil {
-		logger.Debug("Error fetching latest milestone", "err", err)
-		return nil, err
-	}
-
-	var milestone hmtypes.Milestone
-	if err = json.Unmarshal(response.Result, &milestone); err != nil {
-		logger.Error("Error unmarshalling latest milestone", "url", LatestMilestoneURL, "err", err)
+		logger.Debug("Error fetching latest milestone", "url", LatestMilestoneURL, "err", err)
 		return nil, err
 	}
 
@@ -542,19 +432,9 @@ func GetLatestMilestone(cliCtx cliContext.CLIContext) (*hmtypes.Milestone, error
 
 // GetMilestoneCount return params
 func GetMilestoneCount(cliCtx cliContext.CLIContext) (*milestoneTypes.Count, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(MilestoneCountURL),
-	)
-
+	count, _, err := restclient.New(cliCtx).GetMilestoneCount()
 	if err != nil {
-		logger.Error("Error fetching Milestone count", "err", err)
-		return nil, err
-	}
-
-	var count milestoneTypes.Count
-	if err := json.Unmarshal(response.Result, &count); err != nil {
-		logger.Error("Error unmarshalling milestone Count", "url", MilestoneCountURL)
+		logger.Error("Error fetching Milestone count", "url", MilestoneCountURL, "err", err)
 		return nil, err
 	}
 
@@ -574,75 +454,47 @@ func AppendPrefix(signerPubKey []byte) []byte {
 
 // GetValidatorNonce fetches validator nonce and height
 func GetValidatorNonce(cliCtx cliContext.CLIContext, validatorID uint64) (uint64, int64, error) {
-	var validator hmtypes.Validator
-
-	result, err := helper.FetchFromAPI(cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorURL, strconv.FormatUint(validatorID, 10))),
-	)
-
+	validator, height, err := restclient.New(cliCtx).GetValidator(validatorID)
 	if err != nil {
 		logger.Error("Error fetching validator data", "error", err)
 		return 0, 0, err
 	}
 
-	if err = jsoniter.ConfigFastest.Unmarshal(result.Result, &validator); err != nil {
-		logger.Error("error unmarshalling validator data", "error", err)
-		return 0, 0, err
-	}
-
 	logger.Debug("Validator data received ", "validator", validator.String())
 
-	return validator.Nonce, result.Height, nil
+	return validator.Nonce, height, nil
 }
 
 // GetValidatorSet fetches the current validator set
 func GetValidatorSet(cliCtx cliContext.CLIContext) (*hmtypes.ValidatorSet, error) {
-	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
+	validatorSet, _, err := restclient.New(cliCtx).GetValidatorSet()
 	if err != nil {
 		logger.Error("Unable to send request for current validatorset", "url", CurrentValidatorSetURL, "error", err)
 		return nil, err
 	}
 
-	var validatorSet hmtypes.ValidatorSet
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &validatorSet); err != nil {
-		logger.Error("Error unmarshalling current validatorset data ", "error", err)
-		return nil, err
-	}
-
 	return &validatorSet, nil
 }
 
 // GetBlockHeight return last successful checkpoint
 func GetBlockHeight(cliCtx cliContext.CLIContext) int64 {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(CountCheckpointURL),
-	)
+	_, height, err := restclient.New(cliCtx).GetCheckpointCount()
 	if err != nil {
 		logger.Debug("Error fetching latest block height", "err", err)
 		return 0
 	}
 
-	return response.Height
+	return height
 }
 
 // GetClerkEventRecord return last successful checkpoint
 func GetClerkEventRecord(cliCtx cliContext.CLIContext, stateId int64) (*clerktypes.EventRecord, error) {
-	response, err := helper.FetchFromAPI(
-		cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ClerkEventRecordURL, stateId)),
-	)
+	eventRecord, _, err := restclient.New(cliCtx).GetEventRecord(stateId)
 	if err != nil {
 		logger.Error("Error fetching event record by state ID", "error", err)
 		return nil, err
 	}
 
-	var eventRecord clerktypes.EventRecord
-	if err = jsoniter.ConfigFastest.Unmarshal(response.Result, &eventRecord); err != nil {
-		logger.Error("Error unmarshalling event record", "error", err)
-		return nil, err
-	}
-
 	return &eventRecord, nil
 }
 
@@ -26,6 +26,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.GetCommands(
 			GetQueryParams(cdc),
+			GetQueryPendingMigrations(cdc),
 		)...,
 	)
 
@@ -64,3 +65,37 @@ $ %s query chainmanager params
 		},
 	}
 }
+
+// GetQueryPendingMigrations implements the pending migrations query command.
+func GetQueryPendingMigrations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-migrations",
+		Args:  cobra.NoArgs,
+		Short: "show the chain params migrations scheduled by governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the chain params migrations scheduled by governance, by height.
+
+Example:
+$ %s query chainmanager pending-migrations
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingMigrations)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var migrations []types.ChainParamsMigration
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &migrations); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(migrations)
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govCli "github.com/maticnetwork/heimdall/gov/client/cli"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+var logger = helper.Logger.With("module", "chainmanager/client/cli")
+
+// ChainParamsMigrationProposalJSON defines a ChainParamsMigrationProposal with a deposit used
+// to parse chain params migration proposals from a JSON file.
+type ChainParamsMigrationProposalJSON struct {
+	Title       string                     `json:"title" yaml:"title"`
+	Description string                     `json:"description" yaml:"description"`
+	Migration   types.ChainParamsMigration `json:"migration" yaml:"migration"`
+	Deposit     sdk.Coins                  `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitMigrationProposal implements a command handler for submitting a chain params
+// migration proposal transaction.
+func GetCmdSubmitMigrationProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-params-migration [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a chain params migration proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a chain params migration proposal along with an initial deposit.
+The chain params are replaced by the ones of the migration at the end of the block at
+the migration height, such as after the contracts are redeployed on the root chain.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal chain-params-migration <path/to/proposal.json> --validator-id 1 --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Root chain contracts migration",
+  "description": "Use the redeployed root chain contracts",
+  "migration": {
+    "height": "1000000",
+    "chain_params": {
+      "bor_chain_id": "137",
+      "matic_token_address": "0x...",
+      "staking_manager_address": "0x...",
+      "slash_manager_address": "0x...",
+      "root_chain_address": "0x...",
+      "staking_info_address": "0x...",
+      "state_sender_address": "0x...",
+      "state_receiver_address": "0x0000000000000000000000000000000000001001",
+      "validator_set_address": "0x0000000000000000000000000000000000001000"
+    }
+  },
+  "deposit": [
+    {
+      "denom": "matic",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal ChainParamsMigrationProposalJSON
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(govCli.FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			content := types.NewChainParamsMigrationProposal(proposal.Title, proposal.Description, proposal.Migration)
+
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(govCli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	if err := cmd.MarkFlagRequired(govCli.FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitMigrationProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
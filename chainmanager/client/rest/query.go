@@ -43,6 +43,23 @@ type ContractAddresses struct {
 	ValidatorSetAddress    string `json:"validator_set_address"`
 }
 
+//It represents the chain params migrations scheduled by governance
+//swagger:response chainManagerPendingMigrationsResponse
+type chainManagerPendingMigrationsResponse struct {
+	//in:body
+	Output chainManagerPendingMigrations `json:"output"`
+}
+
+type chainManagerPendingMigrations struct {
+	Height string             `json:"height"`
+	Result []pendingMigration `json:"result"`
+}
+
+type pendingMigration struct {
+	Height      int64             `json:"height"`
+	ChainParams ContractAddresses `json:"chain_params"`
+}
+
 // swagger:route GET /chainmanager/params chain-manager chainManagerParams
 // It returns the chain-manager parameters
 // responses:
@@ -68,7 +85,32 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-//swagger:parameters chainManagerParams
+// swagger:route GET /chainmanager/pending-migrations chain-manager chainManagerPendingMigrations
+// It returns the chain params migrations scheduled by governance, by height
+// responses:
+//   200: chainManagerPendingMigrationsResponse
+// HTTP request handler to query the pending chain params migrations
+func pendingMigrationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", chainTypes.QuerierRoute, chainTypes.QueryPendingMigrations)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters chainManagerParams chainManagerPendingMigrations
 type Height struct {
 
 	//Block Height
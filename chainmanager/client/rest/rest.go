@@ -9,4 +9,5 @@ import (
 // RegisterRoutes registers the auth module REST routes.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/chainmanager/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/pending-migrations", pendingMigrationsHandlerFn(cliCtx)).Methods("GET")
 }
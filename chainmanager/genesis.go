@@ -9,6 +9,10 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+
+	for _, migration := range data.Migrations {
+		keeper.setMigration(ctx, migration)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -17,5 +21,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	return types.NewGenesisState(
 		params,
+		keeper.GetPendingMigrations(ctx),
 	)
 }
@@ -1,13 +1,18 @@
 package chainmanager
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 // Keeper stores all related data
@@ -21,6 +26,8 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// contract caller
 	contractCaller helper.ContractCaller
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
 }
 
 // NewKeeper create new keeper
@@ -30,6 +37,7 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	caller helper.ContractCaller,
+	upgradeKeeper upgrade.Keeper,
 ) Keeper {
 	return Keeper{
 		cdc:            cdc,
@@ -37,6 +45,7 @@ func NewKeeper(
 		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:      codespace,
 		contractCaller: caller,
+		upgradeKeeper:  upgradeKeeper,
 	}
 }
 
@@ -58,3 +67,91 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
+
+// -----------------------------------------------------------------------------
+// Chain params migrations
+
+// IsMigrationActive returns true if the chain params migrations are scheduled and applied at the ctx height
+func (k Keeper) IsMigrationActive(ctx sdk.Context) bool {
+	return k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.ChainParamsMigration)
+}
+
+// ScheduleMigration schedules a chain params migration at a future height. It replaces the
+// migration scheduled at the same height, if any
+func (k Keeper) ScheduleMigration(ctx sdk.Context, migration types.ChainParamsMigration) sdk.Error {
+	if !k.IsMigrationActive(ctx) {
+		return govTypes.ErrInactiveProposalType(k.codespace, types.ProposalTypeChainParamsMigration, upgradeTypes.ChainParamsMigration)
+	}
+
+	if err := migration.ValidateBasic(); err != nil {
+		return govTypes.ErrInvalidProposalContent(k.codespace, err.Error())
+	}
+
+	if migration.Height <= ctx.BlockHeight() {
+		return govTypes.ErrInvalidProposalContent(k.codespace, fmt.Sprintf("migration height %d must be after the current height %d", migration.Height, ctx.BlockHeight()))
+	}
+
+	k.setMigration(ctx, migration)
+
+	return nil
+}
+
+// setMigration stores a chain params migration
+func (k Keeper) setMigration(ctx sdk.Context, migration types.ChainParamsMigration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMigrationKey(migration.Height), k.cdc.MustMarshalBinaryBare(migration))
+}
+
+// GetMigration returns the chain params migration scheduled at height
+func (k Keeper) GetMigration(ctx sdk.Context, height int64) (migration types.ChainParamsMigration, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetMigrationKey(height))
+	if bz == nil {
+		return migration, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &migration)
+
+	return migration, true
+}
+
+// GetPendingMigrations returns the scheduled chain params migrations, by height
+func (k Keeper) GetPendingMigrations(ctx sdk.Context) (migrations []types.ChainParamsMigration) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.MigrationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var migration types.ChainParamsMigration
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &migration)
+
+		migrations = append(migrations, migration)
+	}
+
+	return migrations
+}
+
+// ApplyMigration replaces the chain params with the migration scheduled at the current height, if any
+func (k Keeper) ApplyMigration(ctx sdk.Context) {
+	if !k.IsMigrationActive(ctx) {
+		return
+	}
+
+	migration, found := k.GetMigration(ctx, ctx.BlockHeight())
+	if !found {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	params.ChainParams = migration.ChainParams
+
+	// update chain manager state
+	k.SetParams(ctx, params)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMigrationKey(migration.Height))
+
+	k.Logger(ctx).Info("Updated chain manager state", "params", params)
+}
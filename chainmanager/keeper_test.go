@@ -5,7 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -38,3 +40,60 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	ctx = ctx.WithBlockHeight(10)
+
+	chainParams := app.ChainKeeper.GetParams(ctx).ChainParams
+	chainParams.BorChainID = "15001"
+	chainParams.MaticTokenAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000001")
+	chainParams.StakingManagerAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000002")
+	chainParams.SlashManagerAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000003")
+	chainParams.RootChainAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000004")
+	chainParams.StakingInfoAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000005")
+	chainParams.StateSenderAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000006")
+
+	// the migration must be valid, and after the current height
+	require.NotNil(t, app.ChainKeeper.ScheduleMigration(ctx, types.NewChainParamsMigration(10, chainParams)))
+	require.NotNil(t, app.ChainKeeper.ScheduleMigration(ctx, types.NewChainParamsMigration(20, types.ChainParams{})))
+
+	handler := chainmanager.NewChainParamsMigrationProposalHandler(app.ChainKeeper)
+	proposal := types.NewChainParamsMigrationProposal("Migration", "root chain contracts migration", types.NewChainParamsMigration(20, chainParams))
+	require.Nil(t, proposal.ValidateBasic())
+	require.Nil(t, handler(ctx, proposal))
+	require.Nil(t, app.ChainKeeper.ScheduleMigration(ctx, types.NewChainParamsMigration(15, chainParams)))
+	require.Equal(t, []types.ChainParamsMigration{
+		types.NewChainParamsMigration(15, chainParams),
+		types.NewChainParamsMigration(20, chainParams),
+	}, app.ChainKeeper.GetPendingMigrations(ctx))
+
+	// nothing to apply before the migration height
+	app.ChainKeeper.ApplyMigration(ctx.WithBlockHeight(14))
+	require.NotEqual(t, chainParams, app.ChainKeeper.GetParams(ctx).ChainParams)
+
+	app.ChainKeeper.ApplyMigration(ctx.WithBlockHeight(15))
+	require.Equal(t, chainParams, app.ChainKeeper.GetParams(ctx).ChainParams)
+	require.Len(t, app.ChainKeeper.GetPendingMigrations(ctx), 1)
+
+	// the pending migrations are exported
+	genesisState := chainmanager.ExportGenesis(ctx, app.ChainKeeper)
+	require.Equal(t, app.ChainKeeper.GetPendingMigrations(ctx), genesisState.Migrations)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+}
+
+func TestMigrationBeforeUpgrade(t *testing.T) {
+	t.Parallel()
+
+	// no upgrade is applied without the genesis
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(10)
+
+	migration := types.NewChainParamsMigration(20, types.DefaultParams().ChainParams)
+	require.False(t, app.ChainKeeper.IsMigrationActive(ctx))
+	require.NotNil(t, app.ChainKeeper.ScheduleMigration(ctx, migration))
+
+	handler := chainmanager.NewChainParamsMigrationProposalHandler(app.ChainKeeper)
+	require.NotNil(t, handler(ctx, types.NewChainParamsMigrationProposal("Migration", "root chain contracts migration", migration)))
+	require.Empty(t, app.ChainKeeper.GetPendingMigrations(ctx))
+}
@@ -0,0 +1,35 @@
+package chainmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewChainParamsMigrationProposalHandler new chain params migration proposal handler
+func NewChainParamsMigrationProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		if !k.IsMigrationActive(ctx) {
+			return govTypes.ErrInactiveProposalType(k.codespace, content.ProposalType(), upgradeTypes.ChainParamsMigration)
+		}
+
+		switch c := content.(type) {
+		case types.ChainParamsMigrationProposal:
+			return handleChainParamsMigrationProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized chainmanager proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleChainParamsMigrationProposal(ctx sdk.Context, k Keeper, p types.ChainParamsMigrationProposal) sdk.Error {
+	k.Logger(ctx).Info("Scheduling chain params migration", "height", p.Migration.Height, "chainParams", p.Migration.ChainParams)
+
+	return k.ScheduleMigration(ctx, p.Migration)
+}
@@ -14,6 +14,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryParams:
 			return queryParams(ctx, req, keeper)
+		case types.QueryPendingMigrations:
+			return queryPendingMigrations(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown chainmanager query endpoint")
 		}
@@ -28,3 +30,17 @@ func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, s
 
 	return bz, nil
 }
+
+func queryPendingMigrations(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	migrations := keeper.GetPendingMigrations(ctx)
+	if migrations == nil {
+		migrations = []types.ChainParamsMigration{}
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(migrations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -91,3 +91,19 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 		})
 	}
 }
+
+// TestQueryPendingMigrations queries the pending chain params migrations
+func (suite *QuerierTestSuite) TestQueryPendingMigrations() {
+	t, _, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryPendingMigrations}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingMigrations)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: []byte{},
+	}
+	res, sdkErr := querier(ctx, path, req)
+	require.NoError(t, sdkErr)
+	require.Equal(t, "[]", string(res))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 //
@@ -10,24 +11,40 @@ import (
 
 // GenesisState - all chainmanager state that must be provided at genesis
 type GenesisState struct {
-	Params Params `json:"params" yaml:"params"`
+	Params     Params                 `json:"params" yaml:"params"`
+	Migrations []ChainParamsMigration `json:"migrations,omitempty" yaml:"migrations,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, migrations []ChainParamsMigration) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:     params,
+		Migrations: migrations,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), nil)
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	heights := make(map[int64]bool, len(data.Migrations))
+
+	for _, migration := range data.Migrations {
+		if err := migration.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if heights[migration.Height] {
+			return fmt.Errorf("duplicate chain params migration at height %d", migration.Height)
+		}
+
+		heights[migration.Height] = true
+	}
+
 	return nil
 }
 
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "chainmanager"
@@ -16,3 +20,11 @@ const (
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
 )
+
+// MigrationKeyPrefix prefixes the chain params migrations, by height
+var MigrationKeyPrefix = []byte{0x11}
+
+// GetMigrationKey returns the key of the chain params migration at height
+func GetMigrationKey(height int64) []byte {
+	return append(MigrationKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
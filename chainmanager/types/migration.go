@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ProposalTypeChainParamsMigration defines the type for a ChainParamsMigrationProposal
+const ProposalTypeChainParamsMigration = "ChainParamsMigration"
+
+// Assert ChainParamsMigrationProposal implements govTypes.Content at compile-time
+var _ govTypes.Content = ChainParamsMigrationProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeChainParamsMigration)
+	govTypes.RegisterProposalTypeCodec(ChainParamsMigrationProposal{}, "heimdall/ChainParamsMigrationProposal")
+}
+
+// ChainParamsMigration replaces the chain params at the end of the block at height, such as
+// after the contracts are redeployed on the root chain
+type ChainParamsMigration struct {
+	Height      int64       `json:"height" yaml:"height"`
+	ChainParams ChainParams `json:"chain_params" yaml:"chain_params"`
+}
+
+// NewChainParamsMigration returns a migration to the chain params at height
+func NewChainParamsMigration(height int64, chainParams ChainParams) ChainParamsMigration {
+	return ChainParamsMigration{
+		Height:      height,
+		ChainParams: chainParams,
+	}
+}
+
+// ValidateBasic checks the height and the chain params of the migration
+func (m ChainParamsMigration) ValidateBasic() error {
+	if m.Height <= 0 {
+		return fmt.Errorf("invalid migration height %d", m.Height)
+	}
+
+	if strings.TrimSpace(m.ChainParams.BorChainID) == "" {
+		return fmt.Errorf("missing bor chain id")
+	}
+
+	addresses := []struct {
+		key     string
+		address hmTypes.HeimdallAddress
+	}{
+		{"matic_token_address", m.ChainParams.MaticTokenAddress},
+		{"staking_manager_address", m.ChainParams.StakingManagerAddress},
+		{"slash_manager_address", m.ChainParams.SlashManagerAddress},
+		{"root_chain_address", m.ChainParams.RootChainAddress},
+		{"staking_info_address", m.ChainParams.StakingInfoAddress},
+		{"state_sender_address", m.ChainParams.StateSenderAddress},
+		{"state_receiver_address", m.ChainParams.StateReceiverAddress},
+		{"validator_set_address", m.ChainParams.ValidatorSetAddress},
+	}
+
+	for _, a := range addresses {
+		if a.address.Empty() {
+			return fmt.Errorf("missing %s in chain_params", a.key)
+		}
+	}
+
+	return nil
+}
+
+func (m ChainParamsMigration) String() string {
+	return fmt.Sprintf(`Chain Params Migration:
+  Height:       %d
+  Chain Params: %s`, m.Height, m.ChainParams)
+}
+
+// ChainParamsMigrationProposal defines a proposal scheduling a chain params migration. It
+// replaces the migration scheduled at the same height, if any.
+type ChainParamsMigrationProposal struct {
+	Title       string               `json:"title" yaml:"title"`
+	Description string               `json:"description" yaml:"description"`
+	Migration   ChainParamsMigration `json:"migration" yaml:"migration"`
+}
+
+func NewChainParamsMigrationProposal(title, description string, migration ChainParamsMigration) ChainParamsMigrationProposal {
+	return ChainParamsMigrationProposal{title, description, migration}
+}
+
+// GetTitle returns the title of a chain params migration proposal.
+func (cpmp ChainParamsMigrationProposal) GetTitle() string { return cpmp.Title }
+
+// GetDescription returns the description of a chain params migration proposal.
+func (cpmp ChainParamsMigrationProposal) GetDescription() string { return cpmp.Description }
+
+// ProposalRoute returns the routing key of a chain params migration proposal.
+func (cpmp ChainParamsMigrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a chain params migration proposal.
+func (cpmp ChainParamsMigrationProposal) ProposalType() string {
+	return ProposalTypeChainParamsMigration
+}
+
+// ValidateBasic validates the chain params migration proposal
+func (cpmp ChainParamsMigrationProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(govTypes.DefaultCodespace, cpmp); err != nil {
+		return err
+	}
+
+	if err := cpmp.Migration.ValidateBasic(); err != nil {
+		return govTypes.ErrInvalidProposalContent(govTypes.DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cpmp ChainParamsMigrationProposal) String() string {
+	return fmt.Sprintf(`Chain Params Migration Proposal:
+  Title:       %s
+  Description: %s
+  %s
+`, cpmp.Title, cpmp.Description, cpmp.Migration)
+}
@@ -0,0 +1,178 @@
+package checkpoint
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointHistoryDBName is the name of the checkpoint history db in the node data dir
+const CheckpointHistoryDBName = "checkpoint_history"
+
+var (
+	checkpointEventKey = []byte{0x01} // prefix key to store the events of a checkpoint number
+	proposerStatsKey   = []byte{0x02} // prefix key to store the stats of a proposer
+)
+
+// eventOrder orders the events of a checkpoint recorded at the same height, in the order of the
+// handlers: the timed out checkpoint is flushed by the msg proposing the next one
+var eventOrder = map[types.CheckpointEventType]byte{
+	types.CheckpointEventTimedOut:  0,
+	types.CheckpointEventNoAck:     1,
+	types.CheckpointEventProposed:  2,
+	types.CheckpointEventBuffered:  3,
+	types.CheckpointEventSubmitted: 4,
+	types.CheckpointEventAcked:     5,
+	types.CheckpointEventAdjusted:  6,
+}
+
+// CheckpointHistory keeps the lifecycle events of the checkpoints and the proposers stats in a db
+// of the node, outside of the consensus state. It is written by the nodes running with checkpoint_history
+type CheckpointHistory struct {
+	db  dbm.DB
+	cdc *codec.Codec
+}
+
+// NewCheckpointHistory creates a checkpoint history stored in db
+func NewCheckpointHistory(cdc *codec.Codec, db dbm.DB) *CheckpointHistory {
+	return &CheckpointHistory{
+		db:  db,
+		cdc: cdc,
+	}
+}
+
+// OpenCheckpointHistory opens, or creates, the checkpoint history db in dir
+func OpenCheckpointHistory(cdc *codec.Codec, dir string) (*CheckpointHistory, error) {
+	db, err := dbm.NewGoLevelDB(CheckpointHistoryDBName, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCheckpointHistory(cdc, db), nil
+}
+
+// Record stores event under its checkpoint number and updates the stats of its proposer. The events
+// are keyed by checkpoint number, height, type and proposer, so that an event replayed with its block
+// is recorded once and counted once in the stats
+func (h *CheckpointHistory) Record(event types.CheckpointEvent) error {
+	key := checkpointEventKeyFor(event)
+	if h.db.Has(key) {
+		return nil
+	}
+
+	out, err := h.cdc.MarshalBinaryBare(event)
+	if err != nil {
+		return err
+	}
+
+	stats, err := h.GetProposerStats(event.Proposer)
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case types.CheckpointEventProposed:
+		stats.Proposed++
+	case types.CheckpointEventBuffered:
+		stats.Buffered++
+	case types.CheckpointEventSubmitted:
+		stats.Submitted++
+	case types.CheckpointEventAcked:
+		stats.Acked++
+	case types.CheckpointEventTimedOut:
+		stats.TimedOut++
+	case types.CheckpointEventNoAck:
+		stats.NoAcks++
+	}
+
+	statsOut, err := h.cdc.MarshalBinaryBare(stats)
+	if err != nil {
+		return err
+	}
+
+	batch := h.db.NewBatch()
+	defer batch.Close()
+
+	batch.Set(key, out)
+	batch.Set(proposerStatsKeyFor(event.Proposer), statsOut)
+	batch.Write()
+
+	return nil
+}
+
+// GetEvents returns the recorded events of the checkpoint number, in order
+func (h *CheckpointHistory) GetEvents(number uint64) ([]types.CheckpointEvent, error) {
+	prefix := append(append([]byte{}, checkpointEventKey...), uint64Bytes(number)...)
+
+	iterator := h.db.Iterator(prefix, prefixEnd(prefix))
+	defer iterator.Close()
+
+	events := make([]types.CheckpointEvent, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.CheckpointEvent
+		if err := h.cdc.UnmarshalBinaryBare(iterator.Value(), &event); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetProposerStats returns the stats of proposer, empty stats if nothing was recorded for it
+func (h *CheckpointHistory) GetProposerStats(proposer hmTypes.HeimdallAddress) (types.ProposerStats, error) {
+	stats := types.ProposerStats{Proposer: proposer}
+
+	bz := h.db.Get(proposerStatsKeyFor(proposer))
+	if bz == nil {
+		return stats, nil
+	}
+
+	if err := h.cdc.UnmarshalBinaryBare(bz, &stats); err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// GetAllProposerStats returns the stats of all the proposers with recorded events
+func (h *CheckpointHistory) GetAllProposerStats() ([]types.ProposerStats, error) {
+	iterator := h.db.Iterator(proposerStatsKey, prefixEnd(proposerStatsKey))
+	defer iterator.Close()
+
+	allStats := make([]types.ProposerStats, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ProposerStats
+		if err := h.cdc.UnmarshalBinaryBare(iterator.Value(), &stats); err != nil {
+			return nil, err
+		}
+
+		allStats = append(allStats, stats)
+	}
+
+	return allStats, nil
+}
+
+// Close closes the history db
+func (h *CheckpointHistory) Close() {
+	h.db.Close()
+}
+
+func checkpointEventKeyFor(event types.CheckpointEvent) []byte {
+	key := append(append([]byte{}, checkpointEventKey...), uint64Bytes(event.Number)...)
+	//nolint:gosec
+	key = append(key, uint64Bytes(uint64(event.Height))...)
+	key = append(key, eventOrder[event.Type])
+	key = append(key, []byte(event.Type)...)
+
+	return append(key, event.Proposer.Bytes()...)
+}
+
+func proposerStatsKeyFor(proposer hmTypes.HeimdallAddress) []byte {
+	return append(append([]byte{}, proposerStatsKey...), proposer.Bytes()...)
+}
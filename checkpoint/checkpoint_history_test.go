@@ -0,0 +1,108 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestCheckpointHistory(t *testing.T) {
+	t.Parallel()
+
+	history := checkpoint.NewCheckpointHistory(codec.New(), dbm.NewMemDB())
+
+	proposer1 := hmTypes.HexToHeimdallAddress("123")
+	proposer2 := hmTypes.HexToHeimdallAddress("456")
+
+	// checkpoint 1 of proposer1 times out, proposer2 gets it acked
+	for _, event := range []types.CheckpointEvent{
+		{Number: 1, Type: types.CheckpointEventProposed, Proposer: proposer1, EndBlock: 255, Height: 10},
+		{Number: 1, Type: types.CheckpointEventBuffered, Proposer: proposer1, EndBlock: 255, Height: 11},
+		{Number: 1, Type: types.CheckpointEventTimedOut, Proposer: proposer1, EndBlock: 255, Height: 20},
+		{Number: 1, Type: types.CheckpointEventProposed, Proposer: proposer2, EndBlock: 300, Height: 20},
+		{Number: 1, Type: types.CheckpointEventBuffered, Proposer: proposer2, EndBlock: 300, Height: 21},
+		{Number: 1, Type: types.CheckpointEventSubmitted, Proposer: proposer2, EndBlock: 300, Height: 25},
+		{Number: 1, Type: types.CheckpointEventAcked, Proposer: proposer2, EndBlock: 300, Height: 26},
+		{Number: 2, Type: types.CheckpointEventNoAck, Proposer: proposer1, Height: 40},
+	} {
+		require.NoError(t, history.Record(event))
+	}
+
+	events, err := history.GetEvents(1)
+	require.NoError(t, err)
+	require.Len(t, events, 7)
+	require.Equal(t, types.CheckpointEventProposed, events[0].Type)
+	require.Equal(t, types.CheckpointEventTimedOut, events[2].Type)
+	require.Equal(t, types.CheckpointEventAcked, events[6].Type)
+	require.Equal(t, int64(26), events[6].Height)
+
+	events, err = history.GetEvents(2)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	events, err = history.GetEvents(3)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	stats, err := history.GetProposerStats(proposer1)
+	require.NoError(t, err)
+	require.Equal(t, types.ProposerStats{Proposer: proposer1, Proposed: 1, Buffered: 1, TimedOut: 1, NoAcks: 1}, stats)
+	require.Equal(t, float64(0), stats.SuccessRate())
+
+	stats, err = history.GetProposerStats(proposer2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Submitted)
+	require.Equal(t, float64(1), stats.SuccessRate())
+
+	allStats, err := history.GetAllProposerStats()
+	require.NoError(t, err)
+	require.Len(t, allStats, 2)
+
+	stats, err = history.GetProposerStats(hmTypes.HexToHeimdallAddress("789"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), stats.Proposed)
+}
+
+func TestCheckpointHistoryReplay(t *testing.T) {
+	t.Parallel()
+
+	history := checkpoint.NewCheckpointHistory(codec.New(), dbm.NewMemDB())
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	events := []types.CheckpointEvent{
+		{Number: 1, Type: types.CheckpointEventProposed, Proposer: proposer, EndBlock: 255, Height: 10},
+		{Number: 1, Type: types.CheckpointEventBuffered, Proposer: proposer, EndBlock: 255, Height: 11},
+		{Number: 1, Type: types.CheckpointEventSubmitted, Proposer: proposer, EndBlock: 255, Height: 15},
+		{Number: 1, Type: types.CheckpointEventAcked, Proposer: proposer, EndBlock: 255, Height: 16},
+	}
+
+	for _, event := range events {
+		require.NoError(t, history.Record(event))
+	}
+
+	// the blocks replayed after a restart record the same events again
+	for _, event := range events {
+		require.NoError(t, history.Record(event))
+	}
+
+	recorded, err := history.GetEvents(1)
+	require.NoError(t, err)
+	require.Equal(t, events, recorded)
+
+	stats, err := history.GetProposerStats(proposer)
+	require.NoError(t, err)
+	require.Equal(t, types.ProposerStats{Proposer: proposer, Proposed: 1, Buffered: 1, Submitted: 1, Acked: 1}, stats)
+
+	// the same event type at another height is a new event
+	require.NoError(t, history.Record(types.CheckpointEvent{Number: 1, Type: types.CheckpointEventProposed, Proposer: proposer, EndBlock: 255, Height: 20}))
+
+	stats, err = history.GetProposerStats(proposer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.Proposed)
+}
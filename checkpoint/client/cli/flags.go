@@ -14,4 +14,5 @@ const (
 	FlagAutoConfigure      = "auto-configure"
 	FlagLimit              = "limit"
 	FlagPage               = "page"
+	FlagBorBlock           = "bor-block"
 )
@@ -40,6 +40,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	supplyQueryCmd.AddCommand(
 		client.GetCommands(
 			GetQueryParams(cdc),
+			GetQueryMilestoneParams(cdc),
 			GetCheckpointBuffer(cdc),
 			GetLastNoACK(cdc),
 			GetCheckpointByNumber(cdc),
@@ -47,6 +48,12 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCheckpointLatest(cdc),
 			GetCheckpointList(cdc),
 			GetOverview(cdc),
+			GetMilestoneByBorBlock(cdc),
+			GetMilestonesByBorBlockRange(cdc),
+			GetCheckpointByBorBlock(cdc),
+			GetBorBlockProof(cdc),
+			GetCheckpointHistory(cdc),
+			GetProposerStats(cdc),
 		)...,
 	)
 
@@ -87,6 +94,40 @@ $ %s query checkpoint params
 	}
 }
 
+// GetQueryMilestoneParams implements the milestone params query command.
+func GetQueryMilestoneParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "milestone-params",
+		Args:  cobra.NoArgs,
+		Short: "show the milestone parameters in effect",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as milestone parameters.
+
+Example:
+$ %s query checkpoint milestone-params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneParamSet)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.MilestoneParams
+			if err := jsoniter.ConfigFastest.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
 // GetCheckpointBuffer get checkpoint present in buffer
 func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -180,6 +221,254 @@ func GetCheckpointByNumber(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetMilestoneByBorBlock get the archived milestone which finalized a bor block
+func GetMilestoneByBorBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone-by-block",
+		Short: "get the milestone which finalized a bor block, from a node running with milestone_archive",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlock := viper.GetUint64(FlagBorBlock)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(borBlock))
+			if err != nil {
+				return err
+			}
+
+			// fetch archived milestone
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByBorBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBorBlock, 0, "--bor-block=<bor-block-number>")
+
+	if err := cmd.MarkFlagRequired(FlagBorBlock); err != nil {
+		logger.Error("GetMilestoneByBorBlock | MarkFlagRequired | FlagBorBlock", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetMilestonesByBorBlockRange get the archived milestones which finalized a range of bor blocks
+func GetMilestonesByBorBlockRange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestones-by-block-range",
+		Short: "get the milestones which finalized a range of bor blocks, from a node running with milestone_archive",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			startBlock := viper.GetUint64(FlagStartBlock)
+			endBlock := viper.GetUint64(FlagEndBlock)
+			limit := viper.GetUint64(FlagLimit)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockRangeParams(startBlock, endBlock, limit))
+			if err != nil {
+				return err
+			}
+
+			// fetch archived milestones
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestonesByBorRange), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<first-bor-block-number>")
+	cmd.Flags().Uint64(FlagEndBlock, 0, "--end-block=<last-bor-block-number>")
+	cmd.Flags().Uint64(FlagLimit, 100, "--limit=<max-milestones>")
+
+	if err := cmd.MarkFlagRequired(FlagStartBlock); err != nil {
+		logger.Error("GetMilestonesByBorBlockRange | MarkFlagRequired | FlagStartBlock", "Error", err)
+	}
+
+	if err := cmd.MarkFlagRequired(FlagEndBlock); err != nil {
+		logger.Error("GetMilestonesByBorBlockRange | MarkFlagRequired | FlagEndBlock", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointByBorBlock get the acked checkpoint covering a bor block
+func GetCheckpointByBorBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-by-block",
+		Short: "get the acked checkpoint covering a bor block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlock := viper.GetUint64(FlagBorBlock)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(borBlock))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBorBlock, 0, "--bor-block=<bor-block-number>")
+
+	if err := cmd.MarkFlagRequired(FlagBorBlock); err != nil {
+		logger.Error("GetCheckpointByBorBlock | MarkFlagRequired | FlagBorBlock", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetBorBlockProof get the inclusion proof of a bor block in its checkpoint
+func GetBorBlockProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-proof",
+		Short: "get the inclusion proof of a bor block in its checkpoint, built from the bor headers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlock := viper.GetUint64(FlagBorBlock)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(borBlock))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint covering the block
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var checkpoint hmTypes.CheckpointWithID
+			if err = jsoniter.ConfigFastest.Unmarshal(res, &checkpoint); err != nil {
+				return err
+			}
+
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err = jsoniter.ConfigFastest.Unmarshal(res, &params); err != nil {
+				return err
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			proof, err := types.NewBorBlockProof(contractCallerObj, checkpoint, borBlock, params.ChildBlockInterval)
+			if err != nil {
+				return err
+			}
+
+			out, err := jsoniter.ConfigFastest.MarshalIndent(proof, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBorBlock, 0, "--bor-block=<bor-block-number>")
+
+	if err := cmd.MarkFlagRequired(FlagBorBlock); err != nil {
+		logger.Error("GetBorBlockProof | MarkFlagRequired | FlagBorBlock", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointHistory get the lifecycle events of a checkpoint
+func GetCheckpointHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-history",
+		Short: "get the lifecycle events of a checkpoint, from a node running with checkpoint_history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			headerNumber := viper.GetUint64(FlagHeaderNumber)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint history
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointHistory), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+
+	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
+		logger.Error("GetCheckpointHistory | MarkFlagRequired | FlagHeaderNumber", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetProposerStats get the checkpoint proposing stats of the validators
+func GetProposerStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-stats",
+		Short: "get the checkpoint proposing stats of the validators, from a node running with checkpoint_history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposer hmTypes.HeimdallAddress
+			if proposerStr := viper.GetString(FlagProposerAddress); proposerStr != "" {
+				proposer = hmTypes.HexToHeimdallAddress(proposerStr)
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerStatsParams(proposer))
+			if err != nil {
+				return err
+			}
+
+			// fetch proposer stats
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerStats), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagProposerAddress, "", "--proposer=<proposer-address>, all the proposers if empty")
+
+	return cmd
+}
+
 // GetCheckpointCount get number of checkpoint received count
 func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -43,6 +43,63 @@ type params struct {
 	ChildChainBlockInterval int `json:"child_chain_block_interval"`
 }
 
+// It represents the milestone parameters
+//
+//swagger:response milestoneParamsResponse
+type milestoneParamsResponse struct {
+	//in:body
+	Output milestoneParamsStructure `json:"output"`
+}
+
+type milestoneParamsStructure struct {
+	Height string          `json:"height"`
+	Result milestoneParams `json:"result"`
+}
+
+type milestoneParams struct {
+	MilestoneLength       int `json:"milestone_length"`
+	MilestoneBufferLength int `json:"milestone_buffer_length"`
+	MilestoneBufferTime   int `json:"milestone_buffer_time"`
+	MilestonePruneNumber  int `json:"milestone_prune_number"`
+}
+
+// It represents an archived milestone
+//
+//swagger:response milestoneResponse
+type milestoneResponse struct {
+	//in:body
+	Output milestoneStructure `json:"output"`
+}
+
+type milestoneStructure struct {
+	Height string    `json:"height"`
+	Result milestone `json:"result"`
+}
+
+type milestone struct {
+	Number      int64  `json:"number"`
+	Proposer    string `json:"proposer"`
+	StartBlock  int64  `json:"start_block"`
+	EndBlock    int64  `json:"end_block"`
+	Hash        string `json:"hash"`
+	BorChainId  string `json:"bor_chain_id"`
+	MilestoneId string `json:"milestone_id"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// It represents the archived milestones of a range of bor blocks
+//
+//swagger:response milestoneListResponse
+type milestoneListResponse struct {
+	//in:body
+	Output milestoneListStructure `json:"output"`
+}
+
+type milestoneListStructure struct {
+	Height string      `json:"height"`
+	Result []milestone `json:"result"`
+}
+
 // It represents the checkpoint
 //
 //swagger:response checkpointResponse
@@ -84,6 +141,78 @@ type prepareCheckpoint struct {
 	RootHash   string `json:"root_hash"`
 }
 
+// It represents the inclusion proof of a bor block
+//
+//swagger:response borBlockProofResponse
+type borBlockProofResponse struct {
+	//in:body
+	Output borBlockProofStructure `json:"output"`
+}
+
+type borBlockProofStructure struct {
+	Height string        `json:"height"`
+	Result borBlockProof `json:"result"`
+}
+
+type borBlockProof struct {
+	Block       int64  `json:"block"`
+	Checkpoint  int64  `json:"checkpoint"`
+	HeaderBlock int64  `json:"header_block"`
+	StartBlock  int64  `json:"start_block"`
+	EndBlock    int64  `json:"end_block"`
+	Leaf        string `json:"leaf"`
+	Proof       string `json:"proof"`
+	Root        string `json:"root"`
+}
+
+// It represents the lifecycle events of a checkpoint
+//
+//swagger:response checkpointHistoryResponse
+type checkpointHistoryResponse struct {
+	//in:body
+	Output checkpointHistoryStructure `json:"output"`
+}
+
+type checkpointHistoryStructure struct {
+	Height string            `json:"height"`
+	Result []checkpointEvent `json:"result"`
+}
+
+type checkpointEvent struct {
+	Number     int64  `json:"number"`
+	Type       string `json:"type"`
+	Proposer   string `json:"proposer"`
+	StartBlock int64  `json:"start_block"`
+	EndBlock   int64  `json:"end_block"`
+	RootHash   string `json:"root_hash"`
+	Height     int64  `json:"height"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// It represents the checkpoint proposers stats
+//
+//swagger:response proposerStatsResponse
+type proposerStatsResponse struct {
+	//in:body
+	Output proposerStatsStructure `json:"output"`
+}
+
+type proposerStatsStructure struct {
+	Height string          `json:"height"`
+	Result []proposerStats `json:"result"`
+}
+
+type proposerStats struct {
+	Proposer    string  `json:"proposer"`
+	Proposed    int64   `json:"proposed"`
+	Buffered    int64   `json:"buffered"`
+	Submitted   int64   `json:"submitted"`
+	Acked       int64   `json:"acked"`
+	TimedOut    int64   `json:"timed_out"`
+	NoAcks      int64   `json:"no_acks"`
+	SuccessRate float64 `json:"success_rate"`
+}
+
 // It represents the checkpoint list
 //
 //swagger:response checkpointListResponse
@@ -187,6 +316,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/checkpoints/list", checkpointListhandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/proof", borBlockProofHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/by-block/{block}", checkpointByBorBlockHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/history/{number}", checkpointHistoryHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/proposer-stats", proposerStatsHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/{number}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
 
 	registerQueryMilestoneRoutes(cliCtx, r)
@@ -741,6 +878,242 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//swagger:parameters checkpointByBorBlock
+type checkpointByBorBlockParams struct {
+
+	//Bor block
+	//required:true
+	//in:path
+	Block int64 `json:"block"`
+}
+
+// swagger:route GET /checkpoints/by-block/{block} checkpoint checkpointByBorBlock
+// It returns the acked checkpoint covering a bor block
+// responses:
+//
+//	200: checkpointResponse
+func checkpointByBorBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block
+		block, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(block))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters checkpointHistory
+type checkpointHistoryParams struct {
+
+	//Checkpoint number
+	//required:true
+	//in:path
+	Number int64 `json:"number"`
+}
+
+// swagger:route GET /checkpoints/history/{number} checkpoint checkpointHistory
+// It returns the lifecycle events of a checkpoint, from a node running with checkpoint_history
+// responses:
+//
+//	200: checkpointHistoryResponse
+func checkpointHistoryHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointHistory), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters proposerStats
+type proposerStatsParams struct {
+
+	//Proposer address, all the proposers if empty
+	//in:query
+	Proposer string `json:"proposer"`
+}
+
+// swagger:route GET /checkpoints/proposer-stats checkpoint proposerStats
+// It returns the checkpoint proposing stats of the validators, from a node running with checkpoint_history
+// responses:
+//
+//	200: proposerStatsResponse
+func proposerStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var proposer hmTypes.HeimdallAddress
+
+		if r.URL.Query().Get("proposer") != "" {
+			if !ethcmn.IsHexAddress(r.URL.Query().Get("proposer")) {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, "invalid proposer address")
+				return
+			}
+
+			proposer = hmTypes.HexToHeimdallAddress(r.URL.Query().Get("proposer"))
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerStatsParams(proposer))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerStats), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters borBlockProof
+type borBlockProofParams struct {
+
+	//Bor block
+	//required:true
+	//in:query
+	Block int64 `json:"block"`
+}
+
+// swagger:route GET /checkpoints/proof checkpoint borBlockProof
+// It returns the inclusion proof of a bor block in its checkpoint
+// responses:
+//
+//	200: borBlockProofResponse
+func borBlockProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		if r.URL.Query().Get("block") == "" {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "`block` query param required")
+			return
+		}
+
+		block, ok := rest.ParseUint64OrReturnBadRequest(w, r.URL.Query().Get("block"))
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(block))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query the checkpoint covering the block
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		var checkpoint hmTypes.CheckpointWithID
+		if err = jsoniter.ConfigFastest.Unmarshal(res, &checkpoint); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			RestLogger.Error("Unable to get checkpoint params", "Error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		var params types.Params
+		if err = jsoniter.ConfigFastest.Unmarshal(res, &params); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		contractCallerObj, err := helper.NewContractCaller()
+		if err != nil {
+			RestLogger.Error("Unable to create contract caller", "Block", block, "Error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		proof, err := types.NewBorBlockProof(contractCallerObj, checkpoint, block, params.ChildBlockInterval)
+		if err != nil {
+			RestLogger.Error("Unable to build bor block proof", "Block", block, "Checkpoint", checkpoint.ID, "Error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		result, err := jsoniter.ConfigFastest.Marshal(proof)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
 //swagger:parameters checkpointList
 type checkpointListParams struct {
 
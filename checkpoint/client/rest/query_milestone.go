@@ -18,6 +18,9 @@ func registerQueryMilestoneRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/milestone/latest", milestoneLatestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/count", milestoneCountHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/lastNoAck", latestNoAckMilestoneHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/params", milestoneParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/by-block/{block}", milestoneByBorBlockHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/milestone/by-block-range", milestonesByBorBlockRangeHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/{number}", milestoneByNumberHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/noAck/{id}", noAckMilestoneByIDHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/milestone/ID/{id}", milestoneByIDHandlerFn(cliCtx)).Methods("GET")
@@ -102,6 +105,29 @@ func milestoneCountHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// swagger:route GET /milestone/params milestone milestoneParams
+// It returns the milestone parameters in effect
+// responses:
+//
+//	200: milestoneParamsResponse
+func milestoneParamsHandlerFn(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneParamSet), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func milestoneByNumberHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -144,6 +170,116 @@ func milestoneByNumberHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// swagger:route GET /milestone/by-block/{block} milestone milestoneByBorBlock
+// It returns the archived milestone which finalized a bor block, served by the nodes running with milestone_archive
+// responses:
+//
+//	200: milestoneResponse
+func milestoneByBorBlockHandlerFn(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		block, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(block))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query archived milestone
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestoneByBorBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//swagger:parameters milestonesByBorBlockRange
+type milestonesByBorBlockRangeParams struct {
+
+	//First bor block of the range
+	//required:true
+	//in:query
+	From uint64 `json:"from"`
+
+	//Last bor block of the range
+	//required:true
+	//in:query
+	To uint64 `json:"to"`
+
+	//Maximum number of milestones, at most 1000
+	//required:true
+	//in:query
+	Limit uint64 `json:"limit"`
+}
+
+// swagger:route GET /milestone/by-block-range milestone milestonesByBorBlockRange
+// It returns the archived milestones which finalized a range of bor blocks, served by the nodes running with milestone_archive
+// responses:
+//
+//	200: milestoneListResponse
+func milestonesByBorBlockRangeHandlerFn(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block range
+		from, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("from"))
+		if !ok {
+			return
+		}
+
+		to, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("to"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockRangeParams(from, to, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query archived milestones
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMilestonesByBorRange), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func latestNoAckMilestoneHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
@@ -14,6 +14,10 @@ import (
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
+	if data.MilestoneParams != nil {
+		keeper.SetMilestoneParams(ctx, *data.MilestoneParams)
+	}
+
 	// Set last no-ack
 	if data.LastNoACK > 0 {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
@@ -40,6 +44,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 					"checkpoint", checkpoint.String(),
 					"error", err)
 			}
+
+			if keeper.IsCheckpointIndexActive(ctx) {
+				keeper.SetCheckpointIndex(ctx, checkpointIndex, checkpoint.EndBlock)
+			}
 		}
 	}
 
@@ -57,11 +65,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
+	milestoneParams := keeper.GetMilestoneParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 
 	return types.NewGenesisState(
 		params,
+		&milestoneParams,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
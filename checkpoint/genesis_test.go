@@ -64,8 +64,10 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	}
 
 	params := types.DefaultParams()
+	milestoneParams := types.NewMilestoneParams(16, 80, 300*time.Second, 50)
 	genesisState := types.NewGenesisState(
 		params,
+		&milestoneParams,
 		&bufferedCheckpoint,
 		uint64(lastNoACK),
 		uint64(ackCount),
@@ -80,5 +82,6 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.Equal(t, genesisState.BufferedCheckpoint, actualParams.BufferedCheckpoint)
 	require.Equal(t, genesisState.LastNoACK, actualParams.LastNoACK)
 	require.Equal(t, genesisState.Params, actualParams.Params)
+	require.Equal(t, genesisState.MilestoneParams, actualParams.MilestoneParams)
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
 }
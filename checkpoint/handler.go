@@ -12,6 +12,7 @@ import (
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 // NewHandler creates new handler for handling messages for checkpoint module
@@ -83,6 +84,9 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, _ h
 	timeStamp := uint64(ctx.BlockTime().Unix())
 	params := k.GetParams(ctx)
 
+	// buffered checkpoint flushed after timing out
+	var timedOutCheckpoint *hmTypes.Checkpoint
+
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if err == nil {
 		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
@@ -90,6 +94,8 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, _ h
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
 			k.FlushCheckpointBuffer(ctx)
+
+			timedOutCheckpoint = checkpointBuffer
 		} else {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
 			logger.Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
@@ -176,6 +182,20 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, _ h
 		return common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg").Result()
 	}
 
+	// Record checkpoint history
+	checkpointNumber := k.GetACKCount(ctx) + 1
+	if timedOutCheckpoint != nil {
+		k.RecordCheckpointEvent(ctx, types.CheckpointEventTimedOut, checkpointNumber, *timedOutCheckpoint)
+	}
+
+	k.RecordCheckpointEvent(ctx, types.CheckpointEventProposed, checkpointNumber, hmTypes.Checkpoint{
+		Proposer:   msg.Proposer,
+		StartBlock: msg.StartBlock,
+		EndBlock:   msg.EndBlock,
+		RootHash:   msg.RootHash,
+		BorChainID: msg.BorChainID,
+	})
+
 	// Emit event for checkpoint
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -224,6 +244,14 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 		return common.ErrBadAck(k.Codespace()).Result()
 	}
 
+	// Record checkpoint history
+	k.RecordCheckpointEvent(ctx, types.CheckpointEventSubmitted, msg.Number, hmTypes.Checkpoint{
+		Proposer:   msg.Proposer,
+		StartBlock: msg.StartBlock,
+		EndBlock:   msg.EndBlock,
+		RootHash:   msg.RootHash,
+	})
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCheckpointAck,
@@ -265,7 +293,7 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	}
 
 	//Hardfork to check the validity of the NoAckProposer
-	if ctx.BlockHeight() >= helper.GetAalborgHardForkHeight() {
+	if k.uk.IsUpgradeActive(ctx, upgradeTypes.Aalborg) {
 		timeDiff := currentTime.Sub(lastCheckpointTime)
 
 		//count value is calculated based on the time passed since the last checkpoint
@@ -315,6 +343,14 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	// Update to new proposer
 	//
 
+	// Record checkpoint history, against the proposer skipped by the no-ack
+	skippedValidatorSet := k.sk.GetValidatorSet(ctx)
+	if skippedProposer := skippedValidatorSet.GetProposer(); skippedProposer != nil {
+		k.RecordCheckpointEvent(ctx, types.CheckpointEventNoAck, k.GetACKCount(ctx)+1, hmTypes.Checkpoint{
+			Proposer: skippedProposer.Signer,
+		})
+	}
+
 	// Increment accum (selects new proposer)
 	k.sk.IncrementAccum(ctx, 1)
 
@@ -16,7 +16,7 @@ import (
 // handleMsgMilestone validates milestone transaction
 func handleMsgMilestone(ctx sdk.Context, msg types.MsgMilestone, k Keeper) sdk.Result {
 	logger := k.MilestoneLogger(ctx)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := k.GetMilestoneParams(ctx).MilestoneLength
 
 	//
 	//Get milestone validator set
@@ -120,7 +120,7 @@ func handleMsgMilestoneTimeout(ctx sdk.Context, _ types.MsgMilestoneTimeout, k K
 	currentTime := ctx.BlockTime()
 
 	// Get buffer time from params
-	bufferTime := helper.MilestoneBufferTime
+	bufferTime := k.GetMilestoneParams(ctx).MilestoneBufferTime
 
 	// Fetch last checkpoint from store
 	// TODO figure out how to handle this error
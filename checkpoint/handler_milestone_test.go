@@ -10,7 +10,6 @@ import (
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	errs "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -30,7 +29,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestone() {
 	start := uint64(0)
 	borChainId := "1234"
 	milestoneID := "0000"
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := keeper.GetMilestoneParams(ctx).MilestoneLength
 
 	// check valid milestone
 	// generate proposer for validator set
@@ -219,7 +218,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneExistInStore() {
 	keeper := app.CheckpointKeeper
 	stakingKeeper := app.StakingKeeper
 	start := uint64(0)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := keeper.GetMilestoneParams(ctx).MilestoneLength
 
 	chSim.LoadValidatorSet(t, 2, stakingKeeper, ctx, false, 10, 0)
 	stakingKeeper.IncrementAccum(ctx, 1)
@@ -246,9 +245,9 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneExistInStore() {
 }
 
 func (suite *HandlerTestSuite) SendMilestone(header hmTypes.Milestone) (res sdk.Result) {
-	_, ctx := suite.app, suite.ctx
+	app, ctx := suite.app, suite.ctx
 
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := app.CheckpointKeeper.GetMilestoneParams(ctx).MilestoneLength
 
 	// keeper := app.MilestoneKeeper
 
@@ -318,7 +317,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneTimeout() {
 	)
 	_ = keeper.AddMilestone(ctx, milestone)
 
-	newTime := milestone.TimeStamp + uint64(helper.MilestoneBufferTime) - 1
+	newTime := milestone.TimeStamp + uint64(keeper.GetMilestoneParams(ctx).MilestoneBufferTime) - 1
 	suite.ctx = ctx.WithBlockTime(time.Unix(0, int64(newTime)))
 
 	msgMilestoneTimeout := types.NewMsgMilestoneTimeout(
@@ -330,7 +329,7 @@ func (suite *HandlerTestSuite) TestHandleMsgMilestoneTimeout() {
 	require.True(t, !got.IsOK(), errs.CodeToDefaultMsg(got.Code))
 	require.Equal(t, errs.CodeInvalidMilestoneTimeout, got.Code)
 
-	newTime = milestone.TimeStamp + 2*uint64(helper.MilestoneBufferTime) + 10000000
+	newTime = milestone.TimeStamp + 2*uint64(keeper.GetMilestoneParams(ctx).MilestoneBufferTime) + 10000000
 	suite.ctx = ctx.WithBlockTime(time.Unix(0, int64(newTime)))
 
 	msgMilestoneTimeout = types.NewMsgMilestoneTimeout(
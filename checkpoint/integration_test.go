@@ -37,6 +37,7 @@ func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLICo
 
 	checkpointGenesis := types.NewGenesisState(
 		types.DefaultGenesisState().Params,
+		types.DefaultGenesisState().MilestoneParams,
 		types.DefaultGenesisState().BufferedCheckpoint,
 		types.DefaultGenesisState().LastNoACK,
 		types.DefaultGenesisState().AckCount,
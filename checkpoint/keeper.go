@@ -1,7 +1,9 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,6 +16,8 @@ import (
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 const maxCheckpointListLimit = 10_000 // a checkpoint is ~100 bytes => can fit 10k in 1 MB response
@@ -25,6 +29,7 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	CheckpointKey       = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+	CheckpointIndexKey  = []byte{0x15} // prefix key to index checkpoint numbers by end block
 )
 
 // ModuleCommunicator manages different module interaction
@@ -38,6 +43,8 @@ type Keeper struct {
 	// staking keeper
 	sk staking.Keeper
 	ck chainmanager.Keeper
+	// upgrade keeper
+	uk upgrade.Keeper
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 	// codespace
@@ -47,6 +54,12 @@ type Keeper struct {
 
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+
+	// milestone archive, nil unless the node runs with milestone_archive
+	milestoneArchive *MilestoneArchive
+
+	// checkpoint history, nil unless the node runs with checkpoint_history
+	checkpointHistory *CheckpointHistory
 }
 
 // NewKeeper create new keeper
@@ -57,6 +70,7 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	stakingKeeper staking.Keeper,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
@@ -66,6 +80,7 @@ func NewKeeper(
 		codespace:          codespace,
 		sk:                 stakingKeeper,
 		ck:                 chainKeeper,
+		uk:                 upgradeKeeper,
 		moduleCommunicator: moduleCommunicator,
 	}
 
@@ -180,6 +195,159 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	return checkpoints, nil
 }
 
+// GetCheckpointByBorBlock returns the acked checkpoint covering the bor block
+func (k *Keeper) GetCheckpointByBorBlock(ctx sdk.Context, block uint64) (hmTypes.CheckpointWithID, error) {
+	var (
+		number uint64
+		found  bool
+		err    error
+	)
+
+	if k.IsCheckpointIndexActive(ctx) {
+		number, found = k.getCheckpointNumberFromIndex(ctx, block)
+	} else {
+		number, found, err = k.searchCheckpointNumber(ctx, block)
+		if err != nil {
+			return hmTypes.CheckpointWithID{}, err
+		}
+	}
+
+	if !found {
+		return hmTypes.CheckpointWithID{}, fmt.Errorf("no checkpoint found for bor block %d", block)
+	}
+
+	checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+	if err != nil {
+		return hmTypes.CheckpointWithID{}, err
+	}
+
+	if checkpoint.StartBlock > block || checkpoint.EndBlock < block {
+		return hmTypes.CheckpointWithID{}, fmt.Errorf("no checkpoint found for bor block %d", block)
+	}
+
+	return hmTypes.CheckpointWithID{
+		ID:         number,
+		Proposer:   checkpoint.Proposer,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		RootHash:   checkpoint.RootHash,
+		BorChainID: checkpoint.BorChainID,
+		TimeStamp:  checkpoint.TimeStamp,
+	}, nil
+}
+
+// IsCheckpointIndexActive returns true if the bor block to checkpoint index is maintained at the ctx height
+func (k *Keeper) IsCheckpointIndexActive(ctx sdk.Context) bool {
+	return k.uk.IsUpgradeActive(ctx, upgradeTypes.CheckpointIndex)
+}
+
+// SetCheckpointIndex indexes the checkpoint number by the checkpoint end block
+func (k *Keeper) SetCheckpointIndex(ctx sdk.Context, number uint64, endBlock uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointIndexKey(endBlock), sdk.Uint64ToBigEndian(number))
+}
+
+// DeleteCheckpointIndex removes the index entry of a checkpoint end block
+func (k *Keeper) DeleteCheckpointIndex(ctx sdk.Context, endBlock uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetCheckpointIndexKey(endBlock))
+}
+
+// IndexCheckpoints indexes all the acked checkpoints by end block, used to build the index
+// from the existing checkpoints when it is activated
+func (k *Keeper) IndexCheckpoints(ctx sdk.Context) error {
+	ackCount := k.GetACKCount(ctx)
+
+	for number := uint64(1); number <= ackCount; number++ {
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return err
+		}
+
+		k.SetCheckpointIndex(ctx, number, checkpoint.EndBlock)
+	}
+
+	k.Logger(ctx).Info("Indexed checkpoints by bor block", "count", ackCount)
+
+	return nil
+}
+
+// getCheckpointNumberFromIndex returns the number of the first indexed checkpoint ending at or after block
+func (k *Keeper) getCheckpointNumberFromIndex(ctx sdk.Context, block uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(GetCheckpointIndexKey(block), sdk.PrefixEndBytes(CheckpointIndexKey))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(iterator.Value()), true
+}
+
+// searchCheckpointNumber binary searches the acked checkpoints for the one covering block,
+// used before the index is activated
+func (k *Keeper) searchCheckpointNumber(ctx sdk.Context, block uint64) (uint64, bool, error) {
+	// checkpoints are contiguous and ordered by number, search the first one ending at or after block
+	low, high := uint64(1), k.GetACKCount(ctx)
+
+	for low <= high {
+		number := low + (high-low)/2
+
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return 0, false, err
+		}
+
+		switch {
+		case checkpoint.EndBlock < block:
+			low = number + 1
+		case checkpoint.StartBlock > block:
+			high = number - 1
+		default:
+			return number, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// SetCheckpointHistory sets the history recording the checkpoints lifecycle events.
+// It must be set before the keeper is passed to the handlers and queriers
+func (k *Keeper) SetCheckpointHistory(history *CheckpointHistory) {
+	k.checkpointHistory = history
+}
+
+// GetCheckpointHistory returns the checkpoint history, nil if the node does not record it
+func (k Keeper) GetCheckpointHistory() *CheckpointHistory {
+	return k.checkpointHistory
+}
+
+// RecordCheckpointEvent records a lifecycle event of the checkpoint number in the checkpoint history.
+// Events are only recorded when delivering txs, check txs leave no history
+func (k Keeper) RecordCheckpointEvent(ctx sdk.Context, eventType types.CheckpointEventType, number uint64, checkpoint hmTypes.Checkpoint) {
+	if k.checkpointHistory == nil || ctx.IsCheckTx() {
+		return
+	}
+
+	event := types.CheckpointEvent{
+		Number:     number,
+		Type:       eventType,
+		Proposer:   checkpoint.Proposer,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		RootHash:   checkpoint.RootHash,
+		Height:     ctx.BlockHeight(),
+		//nolint:gosec
+		TimeStamp: uint64(ctx.BlockTime().Unix()),
+	}
+
+	if err := k.checkpointHistory.Record(event); err != nil {
+		k.Logger(ctx).Error("Error while recording checkpoint event", "checkpointNumber", number, "type", eventType, "error", err)
+	}
+}
+
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -212,6 +380,12 @@ func GetCheckpointKey(checkpointNumber uint64) []byte {
 	return append(CheckpointKey, checkpointNumberBytes...)
 }
 
+// GetCheckpointIndexKey appends the big endian end block to the checkpoint index prefix,
+// keeping the index ordered by end block
+func GetCheckpointIndexKey(endBlock uint64) []byte {
+	return append(append([]byte{}, CheckpointIndexKey...), sdk.Uint64ToBigEndian(endBlock)...)
+}
+
 // GetCheckpointIDFromKey get the checkpoint ID from the DB key
 func GetCheckpointIDFromKey(key []byte) (uint64, error) {
 	return strconv.ParseUint(string(key[1:]), 10, 64)
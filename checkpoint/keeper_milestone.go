@@ -6,9 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 var (
@@ -25,6 +26,46 @@ func (k Keeper) MilestoneLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "Milestone")
 }
 
+// SetMilestoneParams sets the milestone parameters
+func (k Keeper) SetMilestoneParams(ctx sdk.Context, params types.MilestoneParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMilestoneParams gets the milestone parameters in effect at the current height.
+// The legacy values are used before the milestone params upgrade, and for any parameter
+// not stored yet or invalid
+func (k Keeper) GetMilestoneParams(ctx sdk.Context) types.MilestoneParams {
+	params := types.DefaultMilestoneParams()
+
+	if !k.uk.IsUpgradeActive(ctx, upgradeTypes.MilestoneParams) {
+		return params
+	}
+
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	// param change proposals leaving invalid milestone params are rejected, this only
+	// guards against the values stored before
+	valid, replaced := params.WithDefaults()
+	if len(replaced) > 0 {
+		k.MilestoneLogger(ctx).Error("Invalid milestone params in store, using the default ones", "params", params.String(), "replaced", replaced)
+	}
+
+	return valid
+}
+
+// SetMilestoneArchive sets the archive keeping every milestone added to the store.
+// It must be set before the keeper is passed to the handlers and queriers
+func (k *Keeper) SetMilestoneArchive(archive *MilestoneArchive) {
+	k.milestoneArchive = archive
+}
+
+// GetMilestoneArchive returns the milestone archive, nil if the node does not archive milestones
+func (k Keeper) GetMilestoneArchive() *MilestoneArchive {
+	return k.milestoneArchive
+}
+
 // AddMilestone adds milestone in the store
 func (k *Keeper) AddMilestone(ctx sdk.Context, milestone hmTypes.Milestone) error {
 	milestoneNumber := k.GetMilestoneCount(ctx) + 1 //GetCount gives the number of previous milestone
@@ -34,12 +75,18 @@ func (k *Keeper) AddMilestone(ctx sdk.Context, milestone hmTypes.Milestone) erro
 		return err
 	}
 
-	pruningNumber := milestoneNumber - helper.MilestonePruneNumber
+	pruningNumber := milestoneNumber - k.GetMilestoneParams(ctx).MilestonePruneNumber
 
 	k.PruneMilestone(ctx, pruningNumber) //Prune the old milestone to reduce the memory consumption
 	k.SetMilestoneCount(ctx, milestoneNumber)
 	k.Logger(ctx).Info("Adding good milestone to state", "milestone", milestone, "milestoneNumber", milestoneNumber)
 
+	if k.milestoneArchive != nil {
+		if err := k.milestoneArchive.Add(milestoneNumber, milestone); err != nil {
+			k.Logger(ctx).Error("Error archiving milestone", "milestoneNumber", milestoneNumber, "error", err)
+		}
+	}
+
 	return nil
 }
 
@@ -4,7 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/params"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	"github.com/stretchr/testify/require"
@@ -196,3 +199,34 @@ func (suite *KeeperTestSuite) TestGetMilestoneTimeout() {
 	val = keeper.GetLastMilestoneTimeout(ctx)
 	require.Equal(t, uint64(21), val)
 }
+
+func (suite *KeeperTestSuite) TestMilestoneParams() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	require.Equal(t, types.DefaultMilestoneParams(), keeper.GetMilestoneParams(ctx))
+
+	milestoneParams := types.NewMilestoneParams(16, 80, 300*time.Second, 50)
+	keeper.SetMilestoneParams(ctx, milestoneParams)
+	require.Equal(t, milestoneParams, keeper.GetMilestoneParams(ctx))
+
+	// a param change proposal leaving invalid milestone params is rejected
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	proposal := paramsTypes.NewParameterChangeProposal("Milestone length", "description", []paramsTypes.ParamChange{
+		paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMilestoneLength), `"100"`),
+	})
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, handler(cacheCtx, proposal))
+
+	proposal.Changes = append(proposal.Changes, paramsTypes.NewParamChange(types.DefaultParamspace, string(types.KeyMilestoneBufferLength), `"500"`))
+	cacheCtx, _ = ctx.CacheContext()
+	require.NoError(t, handler(cacheCtx, proposal))
+
+	// only the invalid params in store are replaced by their default value
+	keeper.SetMilestoneParams(ctx, types.NewMilestoneParams(0, 80, 300*time.Second, 50))
+	require.Equal(t, types.NewMilestoneParams(types.DefaultMilestoneLength, 80, 300*time.Second, 50), keeper.GetMilestoneParams(ctx))
+
+	keeper.SetMilestoneParams(ctx, types.NewMilestoneParams(16, 8, 0, 0))
+	require.Equal(t, types.NewMilestoneParams(16, types.DefaultMilestoneBufferLength, types.DefaultMilestoneBufferTime, types.DefaultMilestonePruneNumber), keeper.GetMilestoneParams(ctx))
+}
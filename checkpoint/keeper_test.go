@@ -106,6 +106,37 @@ func (suite *KeeperTestSuite) TestGetCheckpointList() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetCheckpointByBorBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	proposerAddress := hmTypes.HexToHeimdallAddress("123")
+
+	for i := uint64(0); i < 5; i++ {
+		checkpoint := hmTypes.CreateBlock(i*256, i*256+255, rootHash, proposerAddress, "1234", uint64(time.Now().Unix()))
+		require.NoError(t, keeper.AddCheckpoint(ctx, i+1, checkpoint))
+		keeper.UpdateACKCount(ctx)
+	}
+
+	// checkpoints added before the index activation are indexed by the migration
+	require.True(t, keeper.IsCheckpointIndexActive(ctx))
+	_, err := keeper.GetCheckpointByBorBlock(ctx, 0)
+	require.Error(t, err)
+	require.NoError(t, keeper.IndexCheckpoints(ctx))
+
+	for block, number := range map[uint64]uint64{0: 1, 255: 1, 256: 2, 700: 3, 1279: 5} {
+		result, err := keeper.GetCheckpointByBorBlock(ctx, block)
+		require.NoError(t, err)
+		require.Equal(t, number, result.ID, "block %d", block)
+		require.LessOrEqual(t, result.StartBlock, block)
+		require.GreaterOrEqual(t, result.EndBlock, block)
+	}
+
+	_, err = keeper.GetCheckpointByBorBlock(ctx, 1280)
+	require.Error(t, err)
+}
+
 func (suite *KeeperTestSuite) TestHasStoreValue() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
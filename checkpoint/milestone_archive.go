@@ -0,0 +1,168 @@
+package checkpoint
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// MilestoneArchiveDBName is the name of the milestone archive db in the node data dir
+	MilestoneArchiveDBName = "milestones"
+
+	maxArchivedMilestoneRangeLimit = 1_000 // a milestone is ~200 bytes => can fit 1k in 200 KB response
+)
+
+var (
+	archivedMilestoneKey = []byte{0x01} // prefix key to store milestones by number
+	archivedEndBlockKey  = []byte{0x02} // prefix key to index milestone numbers by end block
+)
+
+// MilestoneArchive keeps every milestone in a db of the node, outside of the consensus state
+// which only keeps the last milestones. It is written by the nodes running with milestone_archive
+type MilestoneArchive struct {
+	db  dbm.DB
+	cdc *codec.Codec
+}
+
+// NewMilestoneArchive creates a milestone archive stored in db
+func NewMilestoneArchive(cdc *codec.Codec, db dbm.DB) *MilestoneArchive {
+	return &MilestoneArchive{
+		db:  db,
+		cdc: cdc,
+	}
+}
+
+// OpenMilestoneArchive opens, or creates, the milestone archive db in dir
+func OpenMilestoneArchive(cdc *codec.Codec, dir string) (*MilestoneArchive, error) {
+	db, err := dbm.NewGoLevelDB(MilestoneArchiveDBName, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMilestoneArchive(cdc, db), nil
+}
+
+// Add stores milestone under its number and indexes it by end block
+func (a *MilestoneArchive) Add(number uint64, milestone hmTypes.Milestone) error {
+	out, err := a.cdc.MarshalBinaryBare(milestone)
+	if err != nil {
+		return err
+	}
+
+	batch := a.db.NewBatch()
+	defer batch.Close()
+
+	batch.Set(archivedMilestoneKeyFor(number), out)
+	batch.Set(archivedEndBlockKeyFor(milestone.EndBlock), uint64Bytes(number))
+	batch.Write()
+
+	return nil
+}
+
+// GetByNumber returns the archived milestone with number, nil if it is not archived
+func (a *MilestoneArchive) GetByNumber(number uint64) (*types.ArchivedMilestone, error) {
+	bz := a.db.Get(archivedMilestoneKeyFor(number))
+	if bz == nil {
+		return nil, nil
+	}
+
+	var milestone hmTypes.Milestone
+	if err := a.cdc.UnmarshalBinaryBare(bz, &milestone); err != nil {
+		return nil, err
+	}
+
+	return &types.ArchivedMilestone{Number: number, Milestone: milestone}, nil
+}
+
+// GetByBorBlock returns the archived milestone which finalized the bor block, nil if no
+// archived milestone covers it
+func (a *MilestoneArchive) GetByBorBlock(block uint64) (*types.ArchivedMilestone, error) {
+	// first milestone ending at or after block
+	iterator := a.db.Iterator(archivedEndBlockKeyFor(block), prefixEnd(archivedEndBlockKey))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, nil
+	}
+
+	milestone, err := a.GetByNumber(binary.BigEndian.Uint64(iterator.Value()))
+	if err != nil || milestone == nil {
+		return nil, err
+	}
+
+	if milestone.StartBlock > block {
+		return nil, nil
+	}
+
+	return milestone, nil
+}
+
+// GetByBorBlockRange returns the archived milestones which finalized at least one of the bor
+// blocks from fromBlock to toBlock, by end block, at most limit (capped to 1000) of them
+func (a *MilestoneArchive) GetByBorBlockRange(fromBlock, toBlock, limit uint64) ([]types.ArchivedMilestone, error) {
+	milestones := []types.ArchivedMilestone{}
+
+	if limit > maxArchivedMilestoneRangeLimit {
+		limit = maxArchivedMilestoneRangeLimit
+	}
+
+	if fromBlock > toBlock {
+		return milestones, nil
+	}
+
+	// milestones ending at or after fromBlock, until the first one starting after toBlock
+	iterator := a.db.Iterator(archivedEndBlockKeyFor(fromBlock), prefixEnd(archivedEndBlockKey))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(milestones)) < limit; iterator.Next() {
+		milestone, err := a.GetByNumber(binary.BigEndian.Uint64(iterator.Value()))
+		if err != nil {
+			return nil, err
+		}
+
+		if milestone == nil {
+			continue
+		}
+
+		if milestone.StartBlock > toBlock {
+			break
+		}
+
+		milestones = append(milestones, *milestone)
+	}
+
+	return milestones, nil
+}
+
+// Close closes the archive db
+func (a *MilestoneArchive) Close() {
+	a.db.Close()
+}
+
+func archivedMilestoneKeyFor(number uint64) []byte {
+	return append(append([]byte{}, archivedMilestoneKey...), uint64Bytes(number)...)
+}
+
+func archivedEndBlockKeyFor(block uint64) []byte {
+	return append(append([]byte{}, archivedEndBlockKey...), uint64Bytes(block)...)
+}
+
+func uint64Bytes(n uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, n)
+
+	return bz
+}
+
+// prefixEnd returns the first key after all the keys starting with prefix
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	end[len(end)-1]++
+
+	return end
+}
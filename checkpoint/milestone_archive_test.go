@@ -0,0 +1,73 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestMilestoneArchive(t *testing.T) {
+	t.Parallel()
+
+	archive := checkpoint.NewMilestoneArchive(codec.New(), dbm.NewMemDB())
+
+	hash := hmTypes.HexToHeimdallHash("123")
+	proposerAddress := hmTypes.HexToHeimdallAddress("123")
+
+	require.NoError(t, archive.Add(1, hmTypes.CreateMilestone(0, 15, hash, proposerAddress, "1234", "0001", 1)))
+	require.NoError(t, archive.Add(2, hmTypes.CreateMilestone(16, 31, hash, proposerAddress, "1234", "0002", 2)))
+	// gap between milestones 2 and 3
+	require.NoError(t, archive.Add(3, hmTypes.CreateMilestone(40, 300, hash, proposerAddress, "1234", "0003", 3)))
+
+	for block, number := range map[uint64]uint64{0: 1, 15: 1, 16: 2, 31: 2, 40: 3, 256: 3, 300: 3} {
+		milestone, err := archive.GetByBorBlock(block)
+		require.NoError(t, err)
+		require.NotNil(t, milestone, "block %d", block)
+		require.Equal(t, number, milestone.Number, "block %d", block)
+	}
+
+	for _, block := range []uint64{35, 301} {
+		milestone, err := archive.GetByBorBlock(block)
+		require.NoError(t, err)
+		require.Nil(t, milestone, "block %d", block)
+	}
+
+	milestone, err := archive.GetByNumber(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), milestone.StartBlock)
+	require.Equal(t, "0002", milestone.MilestoneID)
+
+	milestone, err = archive.GetByNumber(4)
+	require.NoError(t, err)
+	require.Nil(t, milestone)
+
+	// the milestones finalizing at least one block of the range, by end block
+	for _, test := range []struct {
+		fromBlock, toBlock, limit uint64
+		numbers                   []uint64
+	}{
+		{0, 300, 10, []uint64{1, 2, 3}},
+		{15, 16, 10, []uint64{1, 2}},
+		{20, 39, 10, []uint64{2}},
+		{32, 39, 10, nil},
+		{0, 300, 2, []uint64{1, 2}},
+		{301, 500, 10, nil},
+		{300, 0, 10, nil},
+	} {
+		milestones, err := archive.GetByBorBlockRange(test.fromBlock, test.toBlock, test.limit)
+		require.NoError(t, err)
+		require.NotNil(t, milestones)
+
+		var numbers []uint64
+		for _, milestone := range milestones {
+			numbers = append(numbers, milestone.Number)
+		}
+
+		require.Equal(t, test.numbers, numbers, "blocks %d to %d", test.fromBlock, test.toBlock)
+	}
+}
@@ -31,6 +31,12 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryLastNoAck(ctx, req, keeper)
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBorBlock(ctx, req, keeper)
+		case types.QueryCheckpointHistory:
+			return handleQueryCheckpointHistory(req, keeper)
+		case types.QueryProposerStats:
+			return handleQueryProposerStats(req, keeper)
 		case types.QueryNextCheckpoint:
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
 
@@ -44,6 +50,12 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryLatestNoAckMilestone(ctx, keeper)
 		case types.QueryNoAckMilestoneByID:
 			return handleQueryNoAckMilestoneByID(ctx, req, keeper)
+		case types.QueryMilestoneParamSet:
+			return handleQueryMilestoneParams(ctx, keeper)
+		case types.QueryMilestoneByBorBlock:
+			return handleQueryMilestoneByBorBlock(req, keeper)
+		case types.QueryMilestonesByBorRange:
+			return handleQueryMilestonesByBorRange(req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
@@ -88,6 +100,91 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func handleQueryCheckpointByBorBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := keeper.GetCheckpointByBorBlock(ctx, params.BorBlock)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint of bor block %v", params.BorBlock), err.Error()))
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryCheckpointHistory(req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	history := keeper.GetCheckpointHistory()
+	if history == nil {
+		return nil, sdk.ErrUnknownRequest("checkpoint history is not enabled on this node")
+	}
+
+	events, err := history.GetEvents(params.Number)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch history of checkpoint %v", params.Number), err.Error()))
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(events)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryProposerStats(req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	history := keeper.GetCheckpointHistory()
+	if history == nil {
+		return nil, sdk.ErrUnknownRequest("checkpoint history is not enabled on this node")
+	}
+
+	var (
+		allStats []types.ProposerStats
+		err      error
+	)
+
+	if params.Proposer.Empty() {
+		allStats, err = history.GetAllProposerStats()
+	} else {
+		var stats types.ProposerStats
+
+		stats, err = history.GetProposerStats(params.Proposer)
+		allStats = []types.ProposerStats{stats}
+	}
+
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch proposer stats", err.Error()))
+	}
+
+	result := make([]types.ProposerStatsWithRate, len(allStats))
+	for i, stats := range allStats {
+		result[i] = types.ProposerStatsWithRate{ProposerStats: stats, SuccessRate: stats.SuccessRate()}
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func handleQueryCheckpointBuffer(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	res, err := keeper.GetCheckpointFromBuffer(ctx)
 	if err != nil {
@@ -38,6 +38,12 @@ func handleQueryMilestoneByNumber(ctx sdk.Context, req abci.RequestQuery, keeper
 	}
 
 	res, err := keeper.GetMilestoneByNumber(ctx, params.Number)
+	if err != nil && keeper.GetMilestoneArchive() != nil {
+		// pruned from the state, look in the archive
+		archived, err := keeper.GetMilestoneArchive().GetByNumber(params.Number)
+		return marshalArchivedMilestone(keeper, archived, err)
+	}
+
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch milestone", err.Error()))
 	}
@@ -92,3 +98,73 @@ func handleQueryNoAckMilestoneByID(ctx sdk.Context, req abci.RequestQuery, keepe
 
 	return bz, nil
 }
+
+// handleQueryMilestoneParams to get the milestone params in effect
+func handleQueryMilestoneParams(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetMilestoneParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// handleQueryMilestoneByBorBlock to get the archived milestone which finalized a bor block
+func handleQueryMilestoneByBorBlock(req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	archive := keeper.GetMilestoneArchive()
+	if archive == nil {
+		return nil, sdk.ErrUnknownRequest("milestone archive is not enabled on this node")
+	}
+
+	archived, err := archive.GetByBorBlock(params.BorBlock)
+
+	return marshalArchivedMilestone(keeper, archived, err)
+}
+
+// handleQueryMilestonesByBorRange to get the archived milestones which finalized a range of bor blocks
+func handleQueryMilestonesByBorRange(req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockRangeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	archive := keeper.GetMilestoneArchive()
+	if archive == nil {
+		return nil, sdk.ErrUnknownRequest("milestone archive is not enabled on this node")
+	}
+
+	milestones, err := archive.GetByBorBlockRange(params.FromBlock, params.ToBlock, params.Limit)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch archived milestones", err.Error()))
+	}
+
+	bz, err := json.Marshal(milestones)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// marshalArchivedMilestone marshals a milestone fetched from the milestone archive
+func marshalArchivedMilestone(keeper Keeper, milestone *types.ArchivedMilestone, err error) ([]byte, sdk.Error) {
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch archived milestone", err.Error()))
+	}
+
+	if milestone == nil {
+		return nil, common.ErrNoMilestoneFound(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(milestone)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 // NewSideTxHandler returns a side handler for "bank" type messages.
@@ -235,6 +236,8 @@ func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 
 	logger.Info("Previous checkpoint details: EndBlock -", checkpointObj.EndBlock, ", RootHash -", msg.RootHash, " Proposer -", checkpointObj.Proposer)
 
+	previousEndBlock := checkpointObj.EndBlock
+
 	checkpointObj.EndBlock = msg.EndBlock
 	checkpointObj.RootHash = hmTypes.BytesToHeimdallHash(msg.RootHash.Bytes())
 	checkpointObj.Proposer = msg.Proposer
@@ -251,6 +254,15 @@ func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 		return sdk.ErrInternal("Failed to add checkpoint into store").Result()
 	}
 
+	// Move checkpoint index to the new end block
+	if k.IsCheckpointIndexActive(ctx) {
+		k.DeleteCheckpointIndex(ctx, previousEndBlock)
+		k.SetCheckpointIndex(ctx, msg.HeaderIndex, checkpointObj.EndBlock)
+	}
+
+	// Record checkpoint history
+	k.RecordCheckpointEvent(ctx, types.CheckpointEventAdjusted, msg.HeaderIndex, checkpointObj)
+
 	logger.Debug("Checkpoint updated to store", "checkpointNumber", msg.HeaderIndex)
 
 	// Emit event for checkpoints
@@ -331,18 +343,23 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	//nolint:gosec
 	timeStamp := uint64(ctx.BlockTime().Unix())
 
-	// Add checkpoint to buffer with root hash and account hash
-	if err = k.SetCheckpointBuffer(ctx, hmTypes.Checkpoint{
+	checkpoint := hmTypes.Checkpoint{
 		StartBlock: msg.StartBlock,
 		EndBlock:   msg.EndBlock,
 		RootHash:   msg.RootHash,
 		Proposer:   msg.Proposer,
 		BorChainID: msg.BorChainID,
 		TimeStamp:  timeStamp,
-	}); err != nil {
+	}
+
+	// Add checkpoint to buffer with root hash and account hash
+	if err = k.SetCheckpointBuffer(ctx, checkpoint); err != nil {
 		logger.Error("Failed to set checkpoint buffer", "Error", err)
 	}
 
+	// Record checkpoint history
+	k.RecordCheckpointEvent(ctx, types.CheckpointEventBuffered, k.GetACKCount(ctx)+1, checkpoint)
+
 	logger.Debug("New checkpoint into buffer stored",
 		"startBlock", msg.StartBlock,
 		"endBlock", msg.EndBlock,
@@ -412,7 +429,7 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 
 	// adjust checkpoint data if latest checkpoint is already submitted
-	if ctx.BlockHeight() < helper.GetAalborgHardForkHeight() {
+	if !k.uk.IsUpgradeActive(ctx, upgradeTypes.Aalborg) {
 		if checkpointObj.EndBlock > msg.EndBlock {
 			logger.Info("Adjusting endBlock to one already submitted on chain", "endBlock", checkpointObj.EndBlock, "adjustedEndBlock", msg.EndBlock)
 			checkpointObj.EndBlock = msg.EndBlock
@@ -438,6 +455,14 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return sdk.ErrInternal("Failed to add checkpoint into store").Result()
 	}
 
+	// Index checkpoint by end block
+	if k.IsCheckpointIndexActive(ctx) {
+		k.SetCheckpointIndex(ctx, msg.Number, checkpointObj.EndBlock)
+	}
+
+	// Record checkpoint history
+	k.RecordCheckpointEvent(ctx, types.CheckpointEventAcked, msg.Number, *checkpointObj)
+
 	logger.Debug("Checkpoint added to store", "checkpointNumber", msg.Number)
 
 	// Flush buffer
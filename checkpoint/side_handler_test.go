@@ -81,6 +81,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSuccess() {
 	}
 	err := keeper.AddCheckpoint(ctx, 1, checkpoint)
 	require.NoError(t, err)
+	keeper.SetCheckpointIndex(ctx, 1, checkpoint.EndBlock)
 
 	checkpointAdjust := types.MsgCheckpointAdjust{
 		HeaderIndex: 1,
@@ -101,6 +102,14 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSuccess() {
 	require.Equal(t, responseCheckpoint.EndBlock, uint64(512))
 	require.Equal(t, responseCheckpoint.Proposer, hmTypes.HexToHeimdallAddress("456"))
 	require.Equal(t, responseCheckpoint.RootHash, hmTypes.HexToHeimdallHash("456"))
+
+	// index follows the adjusted end block
+	for _, block := range []uint64{256, 512} {
+		checkpointByBlock, err := keeper.GetCheckpointByBorBlock(ctx, block)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), checkpointByBlock.ID)
+		require.Equal(t, uint64(512), checkpointByBlock.EndBlock)
+	}
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSameCheckpointAsRootChain() {
@@ -472,6 +481,11 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 
 		afterAckBufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 		require.Nil(t, afterAckBufferedCheckpoint)
+
+		// acked checkpoint is indexed by bor block
+		checkpointByBlock, err := keeper.GetCheckpointByBorBlock(ctx, header.EndBlock)
+		require.NoError(t, err)
+		require.Equal(t, checkpointNumber, checkpointByBlock.ID)
 	})
 
 	suite.Run("Replay", func() {
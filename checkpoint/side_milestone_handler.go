@@ -17,7 +17,7 @@ import (
 // SideHandleMsgMilestone handles MsgMilestone message for external call
 func SideHandleMsgMilestone(ctx sdk.Context, k Keeper, msg types.MsgMilestone, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
 	// get params
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := k.GetMilestoneParams(ctx).MilestoneLength
 
 	// logger
 	logger := k.MilestoneLogger(ctx)
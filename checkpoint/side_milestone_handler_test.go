@@ -12,7 +12,6 @@ import (
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 )
 
@@ -26,7 +25,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgMilestone() {
 	keeper := app.CheckpointKeeper
 
 	start := uint64(0)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := keeper.GetMilestoneParams(ctx).MilestoneLength
 
 	milestone, err := chSim.GenRandMilestone(start, milestoneLength)
 	require.NoError(t, err)
@@ -186,7 +185,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgMilestone() {
 	keeper := app.CheckpointKeeper
 	stakingKeeper := app.StakingKeeper
 	start := uint64(0)
-	milestoneLength := helper.MilestoneLength
+	milestoneLength := keeper.GetMilestoneParams(ctx).MilestoneLength
 
 	// check valid milestone
 	// generate proposer for validator set
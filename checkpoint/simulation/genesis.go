@@ -37,8 +37,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 	}
 
 	params := types.DefaultParams()
+	milestoneParams := types.DefaultMilestoneParams()
 	genesisState := types.NewGenesisState(
 		params,
+		&milestoneParams,
 		&bufferedCheckpoint,
 		uint64(lastNoACK),
 		uint64(ackCount),
@@ -12,6 +12,8 @@ import (
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
+	// nil in genesis files created before the milestone params, the default ones are used then
+	MilestoneParams *MilestoneParams `json:"milestone_params,omitempty" yaml:"milestone_params,omitempty"`
 
 	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
 	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
@@ -22,6 +24,7 @@ type GenesisState struct {
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
+	milestoneParams *MilestoneParams,
 	bufferedCheckpoint *hmTypes.Checkpoint,
 	lastNoACK uint64,
 	ackCount uint64,
@@ -29,6 +32,7 @@ func NewGenesisState(
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
+		MilestoneParams:    milestoneParams,
 		BufferedCheckpoint: bufferedCheckpoint,
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
@@ -38,8 +42,11 @@ func NewGenesisState(
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
+	milestoneParams := DefaultMilestoneParams()
+
 	return GenesisState{
-		Params: DefaultParams(),
+		Params:          DefaultParams(),
+		MilestoneParams: &milestoneParams,
 	}
 }
 
@@ -50,6 +57,12 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if data.MilestoneParams != nil {
+		if err := data.MilestoneParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if data.AckCount > math.MaxInt {
 		return fmt.Errorf("ack count value out of range for int: %d", data.AckCount)
 	}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointEventType is the type of a checkpoint lifecycle event
+type CheckpointEventType string
+
+// Checkpoint lifecycle event types
+const (
+	CheckpointEventProposed  CheckpointEventType = "proposed"  // checkpoint msg accepted, waiting for side-tx votes
+	CheckpointEventBuffered  CheckpointEventType = "buffered"  // checkpoint approved and stored in the buffer
+	CheckpointEventSubmitted CheckpointEventType = "submitted" // checkpoint submitted to L1, ack msg accepted
+	CheckpointEventAcked     CheckpointEventType = "acked"     // checkpoint ack approved, checkpoint stored
+	CheckpointEventTimedOut  CheckpointEventType = "timed-out" // buffered checkpoint flushed after the buffer time
+	CheckpointEventNoAck     CheckpointEventType = "no-ack"    // proposer skipped by a no-ack
+	CheckpointEventAdjusted  CheckpointEventType = "adjusted"  // acked checkpoint adjusted to the L1 one
+)
+
+// CheckpointEvent is an event of the lifecycle of a checkpoint
+type CheckpointEvent struct {
+	Number     uint64                  `json:"number"`
+	Type       CheckpointEventType     `json:"type"`
+	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+	StartBlock uint64                  `json:"start_block"`
+	EndBlock   uint64                  `json:"end_block"`
+	RootHash   hmTypes.HeimdallHash    `json:"root_hash"`
+	Height     int64                   `json:"height"`
+	TimeStamp  uint64                  `json:"timestamp"`
+}
+
+// ProposerStats are the checkpoint proposing statistics of a validator
+type ProposerStats struct {
+	Proposer  hmTypes.HeimdallAddress `json:"proposer"`
+	Proposed  uint64                  `json:"proposed"`
+	Buffered  uint64                  `json:"buffered"`
+	Submitted uint64                  `json:"submitted"`
+	Acked     uint64                  `json:"acked"`
+	TimedOut  uint64                  `json:"timed_out"`
+	NoAcks    uint64                  `json:"no_acks"`
+}
+
+// SuccessRate returns the share of the buffered checkpoints of the proposer which got acked
+func (s ProposerStats) SuccessRate() float64 {
+	if s.Buffered == 0 {
+		return 0
+	}
+
+	return float64(s.Acked) / float64(s.Buffered)
+}
+
+// ProposerStatsWithRate is the proposer stats query result
+type ProposerStatsWithRate struct {
+	ProposerStats
+	SuccessRate float64 `json:"success_rate"`
+}
+
+// QueryProposerStatsParams defines the params for querying the proposers stats,
+// an empty proposer queries the stats of all the proposers
+type QueryProposerStatsParams struct {
+	Proposer hmTypes.HeimdallAddress
+}
+
+// NewQueryProposerStatsParams creates a new instance of QueryProposerStatsParams
+func NewQueryProposerStatsParams(proposer hmTypes.HeimdallAddress) QueryProposerStatsParams {
+	return QueryProposerStatsParams{Proposer: proposer}
+}
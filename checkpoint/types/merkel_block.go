@@ -0,0 +1,167 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// maxHeaderFetchers is the number of bor headers fetched in parallel to build a block proof
+const maxHeaderFetchers = 16
+
+// FetchBorBlockHeaders fetches the bor headers of the blocks [start, end]
+func FetchBorBlockHeaders(contractCaller helper.IContractCaller, start uint64, end uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, errors.New("start block should not be greater than end block")
+	}
+
+	headers := make([]*ethTypes.Header, end-start+1)
+
+	g := new(errgroup.Group)
+	g.SetLimit(maxHeaderFetchers)
+
+	for i := range headers {
+		i := i
+
+		g.Go(func() error {
+			header, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(start + uint64(i)))
+			if err != nil {
+				return err
+			}
+
+			if header == nil {
+				return errors.New("bor header not found")
+			}
+
+			headers[i] = header
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+// GetBorBlockLeaf returns the leaf of a bor block in the checkpoint root hash tree,
+// computed the same way as bor does when building the checkpoint root hash
+func GetBorBlockLeaf(header *ethTypes.Header) []byte {
+	return ethCrypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetBorBlockProof returns the leaf of the block at index in the checkpoint headers, its
+// merkle proof (siblings from the leaf up, 32 bytes each) and the root hash of the headers.
+// The proof can be verified by the RootChain contract with index = block - start block
+func GetBorBlockProof(headers []*ethTypes.Header, index uint64) ([]byte, []byte, []byte, error) {
+	if index >= uint64(len(headers)) {
+		return nil, nil, nil, errors.New("block index out of the checkpoint range")
+	}
+
+	// leaves are padded with empty leaves up to the next power of two
+	level := make([][]byte, nextPowerOfTwo(uint64(len(headers))))
+	for i := range level {
+		if i < len(headers) {
+			level[i] = GetBorBlockLeaf(headers[i])
+		} else {
+			level[i] = make([]byte, 32)
+		}
+	}
+
+	leaf := level[index]
+
+	var proof []byte
+
+	for position := index; len(level) > 1; position /= 2 {
+		proof = append(proof, level[position^1]...)
+
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = ethCrypto.Keccak256(level[2*i], level[2*i+1])
+		}
+
+		level = next
+	}
+
+	return leaf, proof, level[0], nil
+}
+
+// NewBorBlockProof fetches the bor headers of checkpoint and builds the inclusion proof of
+// block, checking that the headers root hash matches the checkpoint root hash
+func NewBorBlockProof(contractCaller helper.IContractCaller, checkpoint hmTypes.CheckpointWithID, block uint64, childBlockInterval uint64) (*BorBlockProof, error) {
+	if block < checkpoint.StartBlock || block > checkpoint.EndBlock {
+		return nil, fmt.Errorf("bor block %d is not in checkpoint %d", block, checkpoint.ID)
+	}
+
+	headers, err := FetchBorBlockHeaders(contractCaller, checkpoint.StartBlock, checkpoint.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf, proof, root, err := GetBorBlockProof(headers, block-checkpoint.StartBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(root, checkpoint.RootHash.Bytes()) {
+		return nil, fmt.Errorf("root hash of bor headers %s does not match checkpoint %d root hash %s", common.BytesToHash(root), checkpoint.ID, checkpoint.RootHash)
+	}
+
+	return &BorBlockProof{
+		Block:       block,
+		Checkpoint:  checkpoint.ID,
+		HeaderBlock: checkpoint.ID * childBlockInterval,
+		StartBlock:  checkpoint.StartBlock,
+		EndBlock:    checkpoint.EndBlock,
+		Leaf:        leaf,
+		Proof:       proof,
+		Root:        common.BytesToHash(root),
+	}, nil
+}
+
+// VerifyBorBlockProof checks the merkle proof of a leaf at index against root,
+// as done by the RootChain contract
+func VerifyBorBlockProof(leaf []byte, index uint64, proof []byte, root []byte) bool {
+	if len(proof)%32 != 0 {
+		return false
+	}
+
+	hash := leaf
+
+	for i := 0; i < len(proof); i += 32 {
+		if index%2 == 0 {
+			hash = ethCrypto.Keccak256(hash, proof[i:i+32])
+		} else {
+			hash = ethCrypto.Keccak256(proof[i:i+32], hash)
+		}
+
+		index /= 2
+	}
+
+	return bytes.Equal(hash, root)
+}
+
+func nextPowerOfTwo(n uint64) uint64 {
+	power := uint64(1)
+	for power < n {
+		power <<= 1
+	}
+
+	return power
+}
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func borHeaders(start uint64, count int) []*ethTypes.Header {
+	headers := make([]*ethTypes.Header, count)
+	for i := range headers {
+		number := start + uint64(i)
+		headers[i] = &ethTypes.Header{
+			Number:      new(big.Int).SetUint64(number),
+			Time:        1600000000 + 2*number,
+			TxHash:      common.BigToHash(new(big.Int).SetUint64(number + 1)),
+			ReceiptHash: common.BigToHash(new(big.Int).SetUint64(number + 2)),
+		}
+	}
+
+	return headers
+}
+
+func TestGetBorBlockLeaf(t *testing.T) {
+	t.Parallel()
+
+	header := borHeaders(256, 1)[0]
+
+	// leaf as computed by the RootChain contract: keccak256(abi.encodePacked(number, time, txRoot, receiptRoot))
+	expected := ethCrypto.Keccak256(
+		common.BigToHash(header.Number).Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(header.Time)).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	)
+
+	require.Equal(t, expected, types.GetBorBlockLeaf(header))
+}
+
+func TestGetBorBlockProof(t *testing.T) {
+	t.Parallel()
+
+	// single block, the root is the leaf
+	headers := borHeaders(100, 1)
+	leaf, proof, root, err := types.GetBorBlockProof(headers, 0)
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.Equal(t, leaf, root)
+
+	// three blocks, padded with an empty leaf
+	headers = borHeaders(100, 3)
+	leaves := [][]byte{types.GetBorBlockLeaf(headers[0]), types.GetBorBlockLeaf(headers[1]), types.GetBorBlockLeaf(headers[2]), make([]byte, 32)}
+	expectedRoot := ethCrypto.Keccak256(ethCrypto.Keccak256(leaves[0], leaves[1]), ethCrypto.Keccak256(leaves[2], leaves[3]))
+
+	leaf, proof, root, err = types.GetBorBlockProof(headers, 2)
+	require.NoError(t, err)
+	require.Equal(t, leaves[2], leaf)
+	require.Equal(t, append(append([]byte{}, leaves[3]...), ethCrypto.Keccak256(leaves[0], leaves[1])...), proof)
+	require.Equal(t, expectedRoot, root)
+
+	_, _, _, err = types.GetBorBlockProof(headers, 3)
+	require.Error(t, err)
+
+	// every block of a checkpoint can be verified against the root
+	headers = borHeaders(1000, 37)
+	for index := range headers {
+		leaf, proof, root, err := types.GetBorBlockProof(headers, uint64(index))
+		require.NoError(t, err)
+		require.Len(t, proof, 6*32)
+		require.True(t, types.VerifyBorBlockProof(leaf, uint64(index), proof, root), "index %d", index)
+		require.False(t, types.VerifyBorBlockProof(leaf, uint64(index)^1, proof, root), "index %d", index)
+	}
+}
+
+func TestNewBorBlockProof(t *testing.T) {
+	t.Parallel()
+
+	headers := borHeaders(256, 10)
+	contractCaller := mocks.IContractCaller{}
+
+	for _, header := range headers {
+		contractCaller.On("GetMaticChainBlock", header.Number).Return(header, nil)
+	}
+
+	_, _, root, err := types.GetBorBlockProof(headers, 0)
+	require.NoError(t, err)
+
+	checkpoint := hmTypes.CheckpointWithID{
+		ID:         3,
+		StartBlock: 256,
+		EndBlock:   265,
+		RootHash:   hmTypes.BytesToHeimdallHash(root),
+	}
+
+	proof, err := types.NewBorBlockProof(&contractCaller, checkpoint, 260, 10000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000), proof.HeaderBlock)
+	require.Equal(t, common.BytesToHash(root), proof.Root)
+	require.True(t, types.VerifyBorBlockProof(proof.Leaf, 260-256, proof.Proof, proof.Root.Bytes()))
+
+	_, err = types.NewBorBlockProof(&contractCaller, checkpoint, 266, 10000)
+	require.Error(t, err)
+
+	checkpoint.RootHash = hmTypes.HexToHeimdallHash("123")
+	_, err = types.NewBorBlockProof(&contractCaller, checkpoint, 260, 10000)
+	require.Error(t, err)
+}
@@ -50,9 +50,9 @@ func NewParams(
 	}
 }
 
-// ParamKeyTable for auth module
+// ParamKeyTable for checkpoint module, including the milestone parameters
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).RegisterParamSet(&MilestoneParams{})
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default milestone parameter values, same as the legacy values used before the params activation
+const (
+	DefaultMilestoneLength       = helper.MilestoneLength
+	DefaultMilestoneBufferLength = helper.MilestoneBufferLength
+	DefaultMilestoneBufferTime   = helper.MilestoneBufferTime
+	DefaultMilestonePruneNumber  = helper.MilestonePruneNumber
+)
+
+// Milestone parameter keys
+var (
+	KeyMilestoneLength       = []byte("MilestoneLength")
+	KeyMilestoneBufferLength = []byte("MilestoneBufferLength")
+	KeyMilestoneBufferTime   = []byte("MilestoneBufferTime")
+	KeyMilestonePruneNumber  = []byte("MilestonePruneNumber")
+)
+
+var _ subspace.ParamSet = &MilestoneParams{}
+
+// MilestoneParams defines the milestone parameters, stored in the checkpoint subspace
+type MilestoneParams struct {
+	// minimum number of bor blocks in a milestone
+	MilestoneLength uint64 `json:"milestone_length" yaml:"milestone_length"`
+	// number of bor blocks without milestone after which a milestone timeout is proposed
+	MilestoneBufferLength uint64 `json:"milestone_buffer_length" yaml:"milestone_buffer_length"`
+	// time after the last milestone before a milestone timeout is accepted
+	MilestoneBufferTime time.Duration `json:"milestone_buffer_time" yaml:"milestone_buffer_time"`
+	// number of milestones kept in state
+	MilestonePruneNumber uint64 `json:"milestone_prune_number" yaml:"milestone_prune_number"`
+}
+
+// NewMilestoneParams creates a new MilestoneParams object
+func NewMilestoneParams(
+	milestoneLength uint64,
+	milestoneBufferLength uint64,
+	milestoneBufferTime time.Duration,
+	milestonePruneNumber uint64,
+) MilestoneParams {
+	return MilestoneParams{
+		MilestoneLength:       milestoneLength,
+		MilestoneBufferLength: milestoneBufferLength,
+		MilestoneBufferTime:   milestoneBufferTime,
+		MilestonePruneNumber:  milestonePruneNumber,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of the milestone parameters.
+// nolint
+func (p *MilestoneParams) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyMilestoneLength, &p.MilestoneLength},
+		{KeyMilestoneBufferLength, &p.MilestoneBufferLength},
+		{KeyMilestoneBufferTime, &p.MilestoneBufferTime},
+		{KeyMilestonePruneNumber, &p.MilestonePruneNumber},
+	}
+}
+
+// Equal returns a boolean determining if two MilestoneParams types are identical.
+func (p MilestoneParams) Equal(p2 MilestoneParams) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultMilestoneParams returns a default set of milestone parameters.
+func DefaultMilestoneParams() MilestoneParams {
+	return MilestoneParams{
+		MilestoneLength:       DefaultMilestoneLength,
+		MilestoneBufferLength: DefaultMilestoneBufferLength,
+		MilestoneBufferTime:   DefaultMilestoneBufferTime,
+		MilestonePruneNumber:  DefaultMilestonePruneNumber,
+	}
+}
+
+// String implements the stringer interface.
+func (p MilestoneParams) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("MilestoneParams: \n")
+	sb.WriteString(fmt.Sprintf("MilestoneLength: %d\n", p.MilestoneLength))
+	sb.WriteString(fmt.Sprintf("MilestoneBufferLength: %d\n", p.MilestoneBufferLength))
+	sb.WriteString(fmt.Sprintf("MilestoneBufferTime: %s\n", p.MilestoneBufferTime))
+	sb.WriteString(fmt.Sprintf("MilestonePruneNumber: %d\n", p.MilestonePruneNumber))
+
+	return sb.String()
+}
+
+// Validate checks that the milestone parameters have valid values.
+func (p MilestoneParams) Validate() error {
+	if p.MilestoneLength == 0 {
+		return fmt.Errorf("MilestoneLength should be greater than zero")
+	}
+
+	if p.MilestoneBufferLength < p.MilestoneLength {
+		return fmt.Errorf("MilestoneBufferLength should not be less than MilestoneLength")
+	}
+
+	if p.MilestoneBufferTime <= 0 {
+		return fmt.Errorf("MilestoneBufferTime should be greater than zero")
+	}
+
+	if p.MilestonePruneNumber == 0 {
+		return fmt.Errorf("MilestonePruneNumber should be greater than zero")
+	}
+
+	return nil
+}
+
+// WithDefaults returns the milestone parameters with each invalid parameter replaced by its
+// default value, and the keys of the replaced parameters. A buffer length less than the
+// milestone length is replaced by the largest of its default value and the milestone length
+func (p MilestoneParams) WithDefaults() (MilestoneParams, []string) {
+	var replaced []string
+
+	if p.MilestoneLength == 0 {
+		p.MilestoneLength = DefaultMilestoneLength
+		replaced = append(replaced, string(KeyMilestoneLength))
+	}
+
+	if p.MilestoneBufferLength < p.MilestoneLength {
+		p.MilestoneBufferLength = DefaultMilestoneBufferLength
+		if p.MilestoneBufferLength < p.MilestoneLength {
+			p.MilestoneBufferLength = p.MilestoneLength
+		}
+
+		replaced = append(replaced, string(KeyMilestoneBufferLength))
+	}
+
+	if p.MilestoneBufferTime <= 0 {
+		p.MilestoneBufferTime = DefaultMilestoneBufferTime
+		replaced = append(replaced, string(KeyMilestoneBufferTime))
+	}
+
+	if p.MilestonePruneNumber == 0 {
+		p.MilestonePruneNumber = DefaultMilestonePruneNumber
+		replaced = append(replaced, string(KeyMilestonePruneNumber))
+	}
+
+	return p, replaced
+}
@@ -1,17 +1,25 @@
 package types
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
 // query endpoints supported by the auth Querier
 const (
-	QueryParams           = "params"
-	QueryAckCount         = "ack-count"
-	QueryCheckpoint       = "checkpoint"
-	QueryCheckpointBuffer = "checkpoint-buffer"
-	QueryLastNoAck        = "last-no-ack"
-	QueryCheckpointList   = "checkpoint-list"
-	QueryNextCheckpoint   = "next-checkpoint"
-	QueryProposer         = "is-proposer"
-	QueryCurrentProposer  = "current-proposer"
-	StakingQuerierRoute   = "staking"
+	QueryParams            = "params"
+	QueryAckCount          = "ack-count"
+	QueryCheckpoint        = "checkpoint"
+	QueryCheckpointBuffer  = "checkpoint-buffer"
+	QueryLastNoAck         = "last-no-ack"
+	QueryCheckpointList    = "checkpoint-list"
+	QueryNextCheckpoint    = "next-checkpoint"
+	QueryProposer          = "is-proposer"
+	QueryCurrentProposer   = "current-proposer"
+	QueryCheckpointByBlock = "checkpoint-by-bor-block"
+	QueryCheckpointHistory = "checkpoint-history"
+	QueryProposerStats     = "proposer-stats"
+	StakingQuerierRoute    = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -33,3 +41,32 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryBorBlockParams defines the params for querying the checkpoint or milestone of a bor block.
+type QueryBorBlockParams struct {
+	BorBlock uint64
+}
+
+// NewQueryBorBlockParams creates a new instance of QueryBorBlockParams.
+func NewQueryBorBlockParams(borBlock uint64) QueryBorBlockParams {
+	return QueryBorBlockParams{BorBlock: borBlock}
+}
+
+// BorBlockProof is the inclusion proof of a bor block in an acked checkpoint.
+// Leaf, proof and root are the inputs of the RootChain contract block verification.
+type BorBlockProof struct {
+	Block       uint64        `json:"block"`
+	Checkpoint  uint64        `json:"checkpoint"`
+	HeaderBlock uint64        `json:"header_block"`
+	StartBlock  uint64        `json:"start_block"`
+	EndBlock    uint64        `json:"end_block"`
+	Leaf        hexutil.Bytes `json:"leaf"`
+	Proof       hexutil.Bytes `json:"proof"`
+	Root        common.Hash   `json:"root"`
+}
+
+// ResultResponse is the result of the REST queries returning a number in a result field,
+// the ack count and the last no-ack time
+type ResultResponse struct {
+	Result uint64 `json:"result"`
+}
@@ -1,11 +1,18 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 const (
 	QueryLatestMilestone      = "milestone-latest"
 	QueryMilestoneByNumber    = "milestone-by-number"
 	QueryCount                = "count"
 	QueryLatestNoAckMilestone = "latest-no-ack-milestone"
 	QueryNoAckMilestoneByID   = "no-ack-milestone-by-id"
+	QueryMilestoneParamSet    = "milestone-params"
+	QueryMilestoneByBorBlock  = "milestone-by-bor-block"
+	QueryMilestonesByBorRange = "milestones-by-bor-block-range"
 )
 
 // QueryMilestoneParams defines the params for querying accounts.
@@ -26,3 +33,21 @@ type QueryMilestoneID struct {
 func NewQueryMilestoneID(id string) QueryMilestoneID {
 	return QueryMilestoneID{MilestoneID: id}
 }
+
+// QueryBorBlockRangeParams defines the params for querying the milestones of a range of bor blocks.
+type QueryBorBlockRangeParams struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Limit     uint64
+}
+
+// NewQueryBorBlockRangeParams creates a new instance of QueryBorBlockRangeParams.
+func NewQueryBorBlockRangeParams(fromBlock, toBlock, limit uint64) QueryBorBlockRangeParams {
+	return QueryBorBlockRangeParams{FromBlock: fromBlock, ToBlock: toBlock, Limit: limit}
+}
+
+// ArchivedMilestone is a milestone of the milestone archive with its number
+type ArchivedMilestone struct {
+	Number uint64 `json:"number"`
+	hmTypes.Milestone
+}
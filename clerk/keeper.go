@@ -13,6 +13,7 @@ import (
 	"github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade"
 )
 
 var (
@@ -38,6 +39,8 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// chain param keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
 }
 
 // NewKeeper create new keeper
@@ -47,13 +50,15 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 ) Keeper {
 	keeper := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		paramSpace:  paramSpace,
-		codespace:   codespace,
-		chainKeeper: chainKeeper,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		codespace:     codespace,
+		chainKeeper:   chainKeeper,
+		upgradeKeeper: upgradeKeeper,
 	}
 
 	return keeper
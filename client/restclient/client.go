@@ -0,0 +1,46 @@
+// Package restclient is a typed client of the heimdall REST server. Its methods are generated from
+// the OpenAPI document of the server, see server/openapi
+package restclient
+
+//go:generate go run ../../server/openapi/gen -client client.gen.go
+
+import (
+	"encoding/json"
+	"net/url"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+// Client queries the REST server at heimdall_rest_server with helper.Client, which sends the
+// heimdall_rest_api_key when set. The methods return the result with the height of the query
+type Client struct {
+	cliCtx cliContext.CLIContext
+}
+
+// New returns a client decoding the amino results with the codec of cliCtx
+func New(cliCtx cliContext.CLIContext) *Client {
+	return &Client{cliCtx: cliCtx}
+}
+
+// get queries path with the query params
+func (c *Client) get(path string, query url.Values) (rest.ResponseWithHeight, error) {
+	endpoint := helper.GetHeimdallServerEndpoint(path)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	return helper.FetchFromAPI(c.cliCtx, endpoint)
+}
+
+// decode decodes a json result
+func (c *Client) decode(bz []byte, v interface{}) error {
+	return json.Unmarshal(bz, v)
+}
+
+// decodeAmino decodes a result encoded by the amino codec
+func (c *Client) decodeAmino(bz []byte, v interface{}) error {
+	return c.cliCtx.Codec.UnmarshalJSON(bz, v)
+}
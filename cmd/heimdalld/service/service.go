@@ -517,6 +517,12 @@ func startInProcess(cmd *cobra.Command, shutdownCtx context.Context, ctx *server
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
+
+		// the app dbs outside of the consensus state are closed once the node is stopped
+		if closer, ok := app.(io.Closer); ok {
+			defer closer.Close()
+		}
+
 		if tmNode.IsRunning() {
 			return tmNode.Stop()
 		}
@@ -591,6 +597,11 @@ func showPrivateKeyCmd() *cobra.Command {
 			// init heimdall config
 			helper.InitHeimdallConfig("")
 
+			if helper.GetConfig().Signer != helper.LocalSigner {
+				fmt.Println("The private key is not available with the", helper.GetConfig().Signer, "signer")
+				return
+			}
+
 			// get private and public keys
 			privObject := helper.GetPrivKey()
 
@@ -232,17 +232,20 @@ $ %s query gov vote 1 3
 
 // GetCmdQueryVotes implements the command to query for proposal votes.
 func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "votes [proposal-id]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Query votes on a proposal",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query vote details for a single proposal by its identifier.
+With --final, query the votes archived when the proposal was tallied, with the
+voting power of the voters at tally time.
 
 Example:
 $ %s query gov votes 1
+$ %s query gov votes 1 --final
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -260,6 +263,17 @@ $ %s query gov votes 1
 				return err
 			}
 
+			if viper.GetBool(flagFinal) {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFinalVotes), bz)
+				if err != nil {
+					return err
+				}
+
+				var votes types.FinalVotes
+				cdc.MustUnmarshalJSON(res, &votes)
+				return cliCtx.PrintOutput(votes)
+			}
+
 			// check to see if the proposal is in the store
 			res, err := gcutils.QueryProposalByID(proposalID, cliCtx, queryRoute)
 			if err != nil {
@@ -285,6 +299,10 @@ $ %s query gov votes 1
 			return cliCtx.PrintOutput(votes)
 		},
 	}
+
+	cmd.Flags().Bool(flagFinal, false, "query the votes archived when the proposal was tallied")
+
+	return cmd
 }
 
 // Command to Get a specific Deposit Information
@@ -32,6 +32,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
+	flagFinal        = "final"
 	FlagProposal     = "proposal"
 	FlagValidatorID  = "validator-id"
 )
@@ -68,6 +69,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitCommunityActionProposal(cdc))[0])
+
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -141,7 +144,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 	cmd.Flags().String(FlagTitle, "", "Title of proposal")
 	cmd.Flags().String(FlagDescription, "", "Description of proposal")
-	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: text")
 	cmd.Flags().String(FlagDeposit, "", "Deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
@@ -152,6 +155,86 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	return cmd
 }
 
+// GetCmdSubmitCommunityActionProposal implements submitting a community action proposal transaction command.
+func GetCmdSubmitCommunityActionProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-action [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community action proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community action proposal along with an initial deposit.
+The actions run in order once the proposal passes, and are dropped altogether if
+one of them fails. The proposal details must be supplied via a JSON file.
+
+Actions:
+  gov/ChangeContractAddressAction   change the address of a contract in the chainmanager params,
+                                    contracts: %s
+
+Example:
+$ %s tx gov submit-proposal community-action <path/to/proposal.json> --validator-id 1 --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Move staking info",
+  "description": "Move the staking info contract",
+  "actions": [
+    {
+      "type": "gov/ChangeContractAddressAction",
+      "value": {
+        "contract": "staking_info",
+        "address": "0x0000000000000000000000000000000000000abc"
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "matic",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				strings.Join([]string{
+					types.ContractMaticToken, types.ContractStakingManager, types.ContractSlashManager, types.ContractRootChain,
+					types.ContractStakingInfo, types.ContractStateSender, types.ContractStateReceiver, types.ContractValidatorSet,
+				}, ", "),
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := govutils.ParseCommunityActionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetInt64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			content := types.NewCommunityActionProposal(proposal.Title, proposal.Description, proposal.Actions)
+			from := helper.GetFromAddress(cliCtx)
+
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.ValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitCommunityActionProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
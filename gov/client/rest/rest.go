@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -181,6 +182,25 @@ type vote struct {
 	Option     string `json:"option"`
 }
 
+//It represents the archived votes responses
+//swagger:response govFinalVotesResponse
+type govFinalVotesResponse struct {
+	//in:body
+	Output govFinalVotesStructure `json:"output"`
+}
+
+type govFinalVotesStructure struct {
+	Height string      `json:"height"`
+	Result []finalVote `json:"result"`
+}
+
+type finalVote struct {
+	ProposalId  string `json:"proposal_id"`
+	Voter       string `json:"voter"`
+	Option      string `json:"option"`
+	VotingPower string `json:"voting_power"`
+}
+
 //It represents the vote response
 //swagger:response govVoteResponse
 type govVoteResponse struct {
@@ -232,6 +252,7 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestFinal          = "final"
 )
 
 // ProposalRESTHandler defines a REST handler implemented in another module. The
@@ -249,6 +270,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, phs []ProposalREST
 	}
 
 	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/gov/proposals/community_action", postCommunityActionProposalHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cliCtx)).Methods("POST")
 
@@ -282,6 +304,18 @@ type PostProposalReq struct {
 	InitialDeposit sdk.Coins               `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
 }
 
+// CommunityActionProposalReq defines the properties of a community action proposal request's body.
+type CommunityActionProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title          string                  `json:"title" yaml:"title"`                     // Title of the proposal
+	Description    string                  `json:"description" yaml:"description"`         // Description of the proposal
+	Actions        []types.Action          `json:"actions" yaml:"actions"`                 // Actions run when the proposal passes
+	Proposer       hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
+	Validator      hmTypes.ValidatorID     `json:"validator" yaml:"validator"`             // id of the validator
+	InitialDeposit sdk.Coins               `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+}
+
 // DepositReq defines the properties of a deposit request's body.
 type DepositReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
@@ -366,6 +400,34 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// swagger:route POST /gov/proposals/community_action gov govCommunityActionProposals
+// It returns the prepared msg for a community action proposal
+// responses:
+//   200: interface{}
+func postCommunityActionProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityActionProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityActionProposal(req.Title, req.Description, req.Actions)
+
+		msg := types.NewMsgSubmitProposal(content, req.InitialDeposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 //swagger:parameters govProposalsDeposits
 type govProposalsDeposits struct {
 
@@ -862,8 +924,16 @@ func queryVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+//swagger:parameters govProposalVotesByProposalId
+type FinalVotesQuery struct {
+
+	//Returns the votes archived when the proposal was tallied, with the voting power of the voters
+	//in:query
+	Final bool `json:"final"`
+}
+
 // swagger:route GET /gov/proposals/{proposal-id}/votes gov govProposalVotesByProposalId
-// It returns the proposal votes based on proposal id
+// It returns the proposal votes based on proposal id, or the votes archived when the proposal was tallied with final=true
 // responses:
 //   200: govVotesResponse
 // todo: Split this functionality into helper functions to remove the above
@@ -888,6 +958,15 @@ func queryVotesOnProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		final := false
+		if strFinal := r.URL.Query().Get(RestFinal); len(strFinal) != 0 {
+			var err error
+			if final, err = strconv.ParseBool(strFinal); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
 		params := types.NewQueryProposalParams(proposalID)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
@@ -896,6 +975,25 @@ func queryVotesOnProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// the votes archived when the proposal was tallied, with the voting power of the voters
+		if final {
+			if res, _, err := cliCtx.QueryWithData("custom/gov/proposal", bz); err != nil || len(res) == 0 {
+				err := fmt.Errorf("proposalID %d does not exist", proposalID)
+				rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+				return
+			}
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", types.QueryFinalVotes), bz)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			rest.PostProcessResponse(w, cliCtx, res)
+			return
+		}
+
 		res, _, err := cliCtx.QueryWithData("custom/gov/proposal", bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
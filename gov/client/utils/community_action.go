@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/gov/types"
+)
+
+// CommunityActionProposalJSON defines a CommunityActionProposal with a deposit used
+// to parse community action proposals from a JSON file.
+type CommunityActionProposalJSON struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Actions     []types.Action `json:"actions" yaml:"actions"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ParseCommunityActionProposalJSON reads and parses a CommunityActionProposalJSON from
+// file. The actions are decoded by the amino codec, with their type.
+func ParseCommunityActionProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityActionProposalJSON, error) {
+	proposal := CommunityActionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,94 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/gov/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewProposalHandler returns the handler of the proposals of the gov module: the text proposals,
+// which do not change the state, and the community action proposals. The handler runs at submit
+// time too, so the proposals are rejected before the gov proposal types upgrade
+func NewProposalHandler(uk UpgradeKeeper, ck ChainKeeper) types.Handler {
+	return func(ctx sdk.Context, content types.Content) sdk.Error {
+		if !uk.IsUpgradeActive(ctx, upgradeTypes.GovProposalTypes) {
+			return types.ErrInactiveProposalType(types.DefaultCodespace, content.ProposalType(), upgradeTypes.GovProposalTypes)
+		}
+
+		switch c := content.(type) {
+		case types.CommunityActionProposal:
+			return handleCommunityActionProposal(ctx, ck, c)
+
+		default:
+			return types.ProposalHandler(ctx, content)
+		}
+	}
+}
+
+// handleCommunityActionProposal runs the actions of the proposal in order, stopping at the
+// first failure. The proposal handler runs in a cache context, so a failure drops the state
+// changes of all the actions
+func handleCommunityActionProposal(ctx sdk.Context, ck ChainKeeper, p types.CommunityActionProposal) sdk.Error {
+	for _, action := range p.Actions {
+		var err sdk.Error
+
+		switch a := action.(type) {
+		case types.ChangeContractAddressAction:
+			err = handleChangeContractAddressAction(ctx, ck, a)
+
+		default:
+			err = types.ErrInvalidAction(types.DefaultCodespace, fmt.Sprintf("unrecognized action type: %s", action.ActionType()))
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommunityAction,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyActionType, action.ActionType()),
+				sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+func handleChangeContractAddressAction(ctx sdk.Context, ck ChainKeeper, a types.ChangeContractAddressAction) sdk.Error {
+	params := ck.GetParams(ctx)
+
+	switch a.Contract {
+	case types.ContractMaticToken:
+		params.ChainParams.MaticTokenAddress = a.Address
+	case types.ContractStakingManager:
+		params.ChainParams.StakingManagerAddress = a.Address
+	case types.ContractSlashManager:
+		params.ChainParams.SlashManagerAddress = a.Address
+	case types.ContractRootChain:
+		params.ChainParams.RootChainAddress = a.Address
+	case types.ContractStakingInfo:
+		params.ChainParams.StakingInfoAddress = a.Address
+	case types.ContractStateSender:
+		params.ChainParams.StateSenderAddress = a.Address
+	case types.ContractStateReceiver:
+		params.ChainParams.StateReceiverAddress = a.Address
+	case types.ContractValidatorSet:
+		params.ChainParams.ValidatorSetAddress = a.Address
+	default:
+		return types.ErrActionFailed(types.DefaultCodespace, a, "unknown contract")
+	}
+
+	if err := params.Validate(); err != nil {
+		return types.ErrActionFailed(types.DefaultCodespace, a, err.Error())
+	}
+
+	ck.SetParams(ctx, params)
+
+	return nil
+}
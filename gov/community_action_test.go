@@ -0,0 +1,76 @@
+package gov
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type testChainKeeper struct {
+	params chainmanagerTypes.Params
+}
+
+func (k *testChainKeeper) GetParams(_ sdk.Context) chainmanagerTypes.Params { return k.params }
+
+func (k *testChainKeeper) SetParams(_ sdk.Context, params chainmanagerTypes.Params) {
+	k.params = params
+}
+
+type testUpgradeKeeper struct {
+	active map[string]bool
+}
+
+func (k *testUpgradeKeeper) IsUpgradeActive(_ sdk.Context, name string) bool { return k.active[name] }
+
+func TestCommunityActionProposalHandler(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+
+	ck := &testChainKeeper{params: chainmanagerTypes.DefaultParams()}
+	uk := &testUpgradeKeeper{active: map[string]bool{upgradeTypes.GovProposalTypes: true}}
+
+	handler := NewProposalHandler(uk, ck)
+
+	// text proposals do not change the state
+	require.Nil(t, handler(ctx, types.NewTextProposal("Test", "signalling proposal")))
+
+	address := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000abc")
+	content := types.NewCommunityActionProposal("Test", "community action proposal", []types.Action{
+		types.NewChangeContractAddressAction(types.ContractRootChain, address),
+		types.NewChangeContractAddressAction(types.ContractStakingInfo, address),
+	})
+
+	require.Nil(t, handler(ctx, content))
+	require.Equal(t, address, ck.params.ChainParams.RootChainAddress)
+	require.Equal(t, address, ck.params.ChainParams.StakingInfoAddress)
+	require.Len(t, ctx.EventManager().Events(), 2)
+}
+
+func TestProposalHandlerBeforeUpgrade(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+
+	ck := &testChainKeeper{params: chainmanagerTypes.DefaultParams()}
+	uk := &testUpgradeKeeper{active: map[string]bool{}}
+
+	handler := NewProposalHandler(uk, ck)
+
+	address := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000abc")
+	for _, content := range []types.Content{
+		types.NewTextProposal("Test", "signalling proposal"),
+		types.NewCommunityActionProposal("Test", "community action proposal", []types.Action{
+			types.NewChangeContractAddressAction(types.ContractRootChain, address),
+		}),
+	} {
+		err := handler(ctx, content)
+		require.NotNil(t, err)
+		require.Equal(t, types.CodeInactiveProposalType, err.Code())
+	}
+
+	require.Equal(t, chainmanagerTypes.DefaultParams(), ck.params)
+}
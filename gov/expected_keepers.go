@@ -3,6 +3,7 @@ package gov
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -18,3 +19,14 @@ type SupplyKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr hmTypes.HeimdallAddress, amt sdk.Coins) sdk.Error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr hmTypes.HeimdallAddress, recipientModule string, amt sdk.Coins) sdk.Error
 }
+
+// ChainKeeper defines the chainmanager Keeper changing the contract addresses
+type ChainKeeper interface {
+	GetParams(ctx sdk.Context) chainmanagerTypes.Params
+	SetParams(ctx sdk.Context, params chainmanagerTypes.Params)
+}
+
+// UpgradeKeeper defines the upgrade Keeper activating the proposal types of the gov module
+type UpgradeKeeper interface {
+	IsUpgradeActive(ctx sdk.Context, name string) bool
+}
@@ -23,6 +23,7 @@ type GenesisState struct {
 	StartingProposalID uint64              `json:"starting_proposal_id" yaml:"starting_proposal_id"`
 	Deposits           types.Deposits      `json:"deposits" yaml:"deposits"`
 	Votes              types.Votes         `json:"votes" yaml:"votes"`
+	FinalVotes         types.FinalVotes    `json:"final_votes" yaml:"final_votes"`
 	Proposals          []types.Proposal    `json:"proposals" yaml:"proposals"`
 	DepositParams      types.DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       types.VotingParams  `json:"voting_params" yaml:"voting_params"`
@@ -118,6 +119,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 		k.setVote(ctx, vote.ProposalID, vote.Voter, vote)
 	}
 
+	for _, vote := range data.FinalVotes {
+		k.setFinalVote(ctx, vote)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
@@ -160,6 +165,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		StartingProposalID: startingProposalID,
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
+		FinalVotes:         k.GetAllFinalVotes(ctx),
 		Proposals:          proposals,
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
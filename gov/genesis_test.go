@@ -0,0 +1,42 @@
+package gov_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/gov"
+	"github.com/maticnetwork/heimdall/gov/types"
+)
+
+func TestFinalVotesGenesis(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	require.True(t, happ.GovKeeper.IsVoteArchiveActive(ctx))
+	require.Empty(t, happ.GovKeeper.GetAllFinalVotes(ctx))
+
+	genesisState := gov.DefaultGenesisState()
+	genesisState.FinalVotes = types.FinalVotes{
+		types.NewFinalVote(1, 1, types.OptionYes, 100),
+		types.NewFinalVote(1, 2, types.OptionNo, 0),
+		types.NewFinalVote(2, 1, types.OptionAbstain, 100),
+	}
+
+	gov.InitGenesis(ctx, happ.GovKeeper, happ.SupplyKeeper, genesisState)
+
+	votes := happ.GovKeeper.GetFinalVotes(ctx, 1)
+	require.Equal(t, genesisState.FinalVotes[:2], votes)
+
+	vote, found := happ.GovKeeper.GetFinalVote(ctx, 2, 1)
+	require.True(t, found)
+	require.Equal(t, types.OptionAbstain, vote.Option)
+	require.Equal(t, int64(100), vote.VotingPower)
+
+	_, found = happ.GovKeeper.GetFinalVote(ctx, 2, 2)
+	require.False(t, found)
+
+	require.Equal(t, genesisState.FinalVotes, gov.ExportGenesis(ctx, happ.GovKeeper).FinalVotes)
+}
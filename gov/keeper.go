@@ -13,6 +13,7 @@ import (
 	"github.com/maticnetwork/heimdall/staking"
 	"github.com/maticnetwork/heimdall/supply"
 	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	"github.com/maticnetwork/heimdall/upgrade"
 )
 
 // Keeper governance Keeper
@@ -26,6 +27,9 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk staking.Keeper
 
+	// The upgrade keeper to check the activation of the vote archive
+	uk upgrade.Keeper
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
@@ -50,6 +54,7 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	supplyKeeper supply.Keeper,
 	sk staking.Keeper,
+	uk upgrade.Keeper,
 	codespace sdk.CodespaceType,
 	rtr Router,
 ) Keeper {
@@ -69,6 +74,7 @@ func NewKeeper(
 		paramSpace:   paramSpace,
 		supplyKeeper: supplyKeeper,
 		sk:           sk,
+		uk:           uk,
 		cdc:          cdc,
 		codespace:    codespace,
 		router:       rtr,
@@ -271,6 +277,38 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// IterateAllFinalVotes iterates over the all the archived votes and performs a callback function
+func (keeper Keeper) IterateAllFinalVotes(ctx sdk.Context, cb func(vote types.FinalVote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FinalVotesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.FinalVote
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// IterateFinalVotes iterates over the archived votes of a proposal and performs a callback function
+func (keeper Keeper) IterateFinalVotes(ctx sdk.Context, proposalID uint64, cb func(vote types.FinalVote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FinalVotesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.FinalVote
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -0,0 +1,34 @@
+package gov_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/gov"
+	"github.com/maticnetwork/heimdall/gov/types"
+)
+
+func TestQueryFinalVotes(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+	querier := gov.NewQuerier(happ.GovKeeper)
+
+	data, err := happ.Codec().MarshalJSON(types.NewQueryProposalParams(1))
+	require.NoError(t, err)
+
+	// an unknown proposal has no archived votes
+	_, sdkErr := querier(ctx, []string{types.QueryFinalVotes}, abci.RequestQuery{Data: data})
+	require.NotNil(t, sdkErr)
+	require.Equal(t, types.CodeUnknownProposal, sdkErr.Code())
+
+	// a proposal not tallied yet has an empty list of archived votes
+	happ.GovKeeper.SetProposal(ctx, types.NewProposal(types.NewTextProposal("Test", "description"), 1, time.Now(), time.Now()))
+
+	res, sdkErr := querier(ctx, []string{types.QueryFinalVotes}, abci.RequestQuery{Data: data})
+	require.Nil(t, sdkErr)
+	require.JSONEq(t, "[]", string(res))
+}
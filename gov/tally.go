@@ -49,11 +49,21 @@ func tally(ctx sdk.Context, keeper Keeper, proposal types.Proposal) (passes bool
 		return false
 	})
 
+	archiveVotes := keeper.IsVoteArchiveActive(ctx)
+
 	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		var votingPower int64
+
 		// if validator, just record it in the map
 		if val, ok := currValidators[vote.Voter]; ok {
 			val.Vote = vote.Option
 			currValidators[vote.Voter] = val
+			votingPower = val.VotingPower
+		}
+
+		// archive the vote with the voting power it is tallied with
+		if archiveVotes {
+			keeper.setFinalVote(ctx, types.NewFinalVote(vote.ProposalID, vote.Voter, vote.Option, votingPower))
 		}
 
 		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
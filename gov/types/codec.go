@@ -11,10 +11,16 @@ var ModuleCdc = codec.New()
 // governance.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Content)(nil), nil)
+	cdc.RegisterInterface((*Action)(nil), nil)
 
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "gov/MsgVote", nil)
+
+	cdc.RegisterConcrete(TextProposal{}, "gov/TextProposal", nil)
+	cdc.RegisterConcrete(CommunityActionProposal{}, "gov/CommunityActionProposal", nil)
+
+	cdc.RegisterConcrete(ChangeContractAddressAction{}, "gov/ChangeContractAddressAction", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Community action proposal type, and the types of its actions
+const (
+	ProposalTypeCommunityAction string = "CommunityAction"
+
+	ActionTypeChangeContractAddress = "ChangeContractAddress"
+
+	// MaxActions is the max number of actions of a community action proposal
+	MaxActions = 16
+)
+
+// Contracts of the chainmanager params changed by a ChangeContractAddressAction
+const (
+	ContractMaticToken     = "matic_token"
+	ContractStakingManager = "staking_manager"
+	ContractSlashManager   = "slash_manager"
+	ContractRootChain      = "root_chain"
+	ContractStakingInfo    = "staking_info"
+	ContractStateSender    = "state_sender"
+	ContractStateReceiver  = "state_receiver"
+	ContractValidatorSet   = "validator_set"
+)
+
+var validContracts = map[string]struct{}{
+	ContractMaticToken:     {},
+	ContractStakingManager: {},
+	ContractSlashManager:   {},
+	ContractRootChain:      {},
+	ContractStakingInfo:    {},
+	ContractStateSender:    {},
+	ContractStateReceiver:  {},
+	ContractValidatorSet:   {},
+}
+
+// Action is a typed action run by a community action proposal once it passes
+type Action interface {
+	ActionType() string
+	ValidateBasic() sdk.Error
+	String() string
+}
+
+// CommunityActionProposal runs its actions in order when it passes. The state changes of the
+// actions are only written if all of them succeed
+type CommunityActionProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Actions     []Action `json:"actions" yaml:"actions"`
+}
+
+// NewCommunityActionProposal returns a community action proposal
+func NewCommunityActionProposal(title, description string, actions []Action) Content {
+	return CommunityActionProposal{title, description, actions}
+}
+
+// Implements Proposal Interface
+var _ Content = CommunityActionProposal{}
+
+// nolint
+func (ca CommunityActionProposal) GetTitle() string       { return ca.Title }
+func (ca CommunityActionProposal) GetDescription() string { return ca.Description }
+func (ca CommunityActionProposal) ProposalRoute() string  { return RouterKey }
+func (ca CommunityActionProposal) ProposalType() string   { return ProposalTypeCommunityAction }
+
+// ValidateBasic validates the abstract and the actions of the proposal
+func (ca CommunityActionProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, ca); err != nil {
+		return err
+	}
+
+	if len(ca.Actions) == 0 {
+		return ErrInvalidAction(DefaultCodespace, "proposal has no actions")
+	}
+
+	if len(ca.Actions) > MaxActions {
+		return ErrInvalidAction(DefaultCodespace, fmt.Sprintf("proposal has %d actions, more than the max of %d", len(ca.Actions), MaxActions))
+	}
+
+	for _, action := range ca.Actions {
+		if action == nil {
+			return ErrInvalidAction(DefaultCodespace, "missing action")
+		}
+
+		if err := action.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ca CommunityActionProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Community Action Proposal:
+  Title:       %s
+  Description: %s
+  Actions:
+`, ca.Title, ca.Description))
+
+	for _, action := range ca.Actions {
+		b.WriteString(fmt.Sprintf("    %s\n", action))
+	}
+
+	return b.String()
+}
+
+// ChangeContractAddressAction changes the address of a contract in the chainmanager params
+type ChangeContractAddressAction struct {
+	Contract string                  `json:"contract" yaml:"contract"`
+	Address  hmTypes.HeimdallAddress `json:"address" yaml:"address"`
+}
+
+// NewChangeContractAddressAction returns an action changing the address of a contract
+func NewChangeContractAddressAction(contract string, address hmTypes.HeimdallAddress) Action {
+	return ChangeContractAddressAction{contract, address}
+}
+
+// ActionType returns the type of the action
+func (a ChangeContractAddressAction) ActionType() string { return ActionTypeChangeContractAddress }
+
+// ValidateBasic checks the contract is known and the address is set
+func (a ChangeContractAddressAction) ValidateBasic() sdk.Error {
+	if _, ok := validContracts[a.Contract]; !ok {
+		return ErrInvalidAction(DefaultCodespace, fmt.Sprintf("unknown contract %s", a.Contract))
+	}
+
+	if a.Address.Empty() {
+		return ErrInvalidAction(DefaultCodespace, fmt.Sprintf("missing address of contract %s", a.Contract))
+	}
+
+	return nil
+}
+
+func (a ChangeContractAddressAction) String() string {
+	return fmt.Sprintf("%s: %s => %s", ActionTypeChangeContractAddress, a.Contract, a.Address)
+}
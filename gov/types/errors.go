@@ -21,6 +21,9 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidAction            sdk.CodeType = 12
+	CodeActionFailed             sdk.CodeType = 13
+	CodeInactiveProposalType     sdk.CodeType = 14
 )
 
 func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
@@ -62,3 +65,15 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+func ErrInvalidAction(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAction, fmt.Sprintf("invalid action: %s", msg))
+}
+
+func ErrActionFailed(codespace sdk.CodespaceType, action Action, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeActionFailed, fmt.Sprintf("action %s failed: %s", action, msg))
+}
+
+func ErrInactiveProposalType(codespace sdk.CodespaceType, proposalType string, upgrade string) sdk.Error {
+	return sdk.NewError(codespace, CodeInactiveProposalType, fmt.Sprintf("proposal type '%s' is not active before the %s upgrade", proposalType, upgrade))
+}
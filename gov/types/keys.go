@@ -41,6 +41,8 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes><voterID_Bytes>: FinalVote
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -50,6 +52,8 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	FinalVotesKeyPrefix = []byte{0x30}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -111,6 +115,18 @@ func VoteKey(proposalID uint64, validator hmTypes.ValidatorID) []byte {
 	return append(VotesKey(proposalID), validator.Bytes()...)
 }
 
+// FinalVotesKey gets the first part of the archived votes key based on the proposalID
+func FinalVotesKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, proposalID)
+	return append(FinalVotesKeyPrefix, bz...)
+}
+
+// FinalVoteKey key of a specific archived vote from the store
+func FinalVoteKey(proposalID uint64, validator hmTypes.ValidatorID) []byte {
+	return append(FinalVotesKey(proposalID), validator.Bytes()...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
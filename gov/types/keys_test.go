@@ -31,6 +31,12 @@ func TestProposalKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 3)
 	require.True(t, now.Equal(expTime))
 
+	// key final vote, ordered by proposal
+	key = FinalVoteKey(3, hmTypes.NewValidatorID(2))
+	require.Equal(t, FinalVotesKeyPrefix, key[:1])
+	require.Equal(t, FinalVotesKey(3), key[:9])
+	require.Equal(t, hmTypes.NewValidatorID(2).Bytes(), key[9:])
+
 	// invalid key
 	require.Panics(t, func() { SplitProposalKey([]byte("test")) })
 	require.Panics(t, func() { SplitInactiveProposalQueueKey([]byte("test")) })
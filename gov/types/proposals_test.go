@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +25,45 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestTextProposal(t *testing.T) {
+	content := ContentFromProposalType("Test", "signalling proposal", ProposalTypeText)
+	require.Equal(t, NewTextProposal("Test", "signalling proposal"), content)
+	require.Nil(t, content.ValidateBasic())
+	require.True(t, IsValidProposalType(content.ProposalType()))
+	require.Nil(t, ProposalHandler(sdk.Context{}, content))
+
+	require.NotNil(t, NewTextProposal("", "signalling proposal").ValidateBasic())
+	require.Nil(t, ContentFromProposalType("Test", "signalling proposal", "Unknown"))
+}
+
+func TestCommunityActionProposal(t *testing.T) {
+	address := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000001001")
+
+	tests := []struct {
+		actions []Action
+		valid   bool
+	}{
+		{[]Action{NewChangeContractAddressAction(ContractStakingInfo, address), NewChangeContractAddressAction(ContractRootChain, address)}, true},
+		{nil, false},
+		{[]Action{nil}, false},
+		{[]Action{NewChangeContractAddressAction("unknown", address)}, false},
+		{[]Action{NewChangeContractAddressAction(ContractStateSender, hmTypes.ZeroHeimdallAddress)}, false},
+		{make([]Action, MaxActions+1), false},
+	}
+
+	for i, tc := range tests {
+		err := NewCommunityActionProposal("Test", "community action proposal", tc.actions).ValidateBasic()
+		require.Equal(t, tc.valid, err == nil, "test case %d", i)
+	}
+
+	// the actions are encoded with their type
+	content := NewCommunityActionProposal("Test", "community action proposal", []Action{NewChangeContractAddressAction(ContractRootChain, address)})
+	bz, err := ModuleCdc.MarshalJSON(content)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"type":"gov/ChangeContractAddressAction"`)
+
+	var decoded Content
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, content, decoded)
+}
@@ -6,14 +6,15 @@ import (
 
 // query endpoints supported by the governance Querier
 const (
-	QueryParams    = "params"
-	QueryProposals = "proposals"
-	QueryProposal  = "proposal"
-	QueryDeposits  = "deposits"
-	QueryDeposit   = "deposit"
-	QueryVotes     = "votes"
-	QueryVote      = "vote"
-	QueryTally     = "tally"
+	QueryParams     = "params"
+	QueryProposals  = "proposals"
+	QueryProposal   = "proposal"
+	QueryDeposits   = "deposits"
+	QueryDeposit    = "deposit"
+	QueryVotes      = "votes"
+	QueryVote       = "vote"
+	QueryTally      = "tally"
+	QueryFinalVotes = "final-votes"
 
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
@@ -25,6 +26,7 @@ const (
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
 // - 'custom/gov/votes'
+// - 'custom/gov/final-votes'
 type QueryProposalParams struct {
 	ProposalID uint64
 }
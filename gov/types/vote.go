@@ -50,6 +50,38 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// FinalVote is a vote archived when its proposal is tallied, with the voting power of the voter at
+// tally time. The votes of the validators out of the validator set at tally time have no power
+type FinalVote struct {
+	ProposalID  uint64              `json:"proposal_id" yaml:"proposal_id"`   //  proposalID of the proposal
+	Voter       hmTypes.ValidatorID `json:"voter" yaml:"voter"`               //  id of the voter
+	Option      VoteOption          `json:"option" yaml:"option"`             //  option from OptionSet chosen by the voter
+	VotingPower int64               `json:"voting_power" yaml:"voting_power"` //  voting power of the voter at tally time
+}
+
+// NewFinalVote creates a new FinalVote instance
+func NewFinalVote(proposalID uint64, voter hmTypes.ValidatorID, option VoteOption, votingPower int64) FinalVote {
+	return FinalVote{proposalID, voter, option, votingPower}
+}
+
+func (v FinalVote) String() string {
+	return fmt.Sprintf("voter %s voted with option %s and voting power %d on proposal %d", v.Voter.String(), v.Option, v.VotingPower, v.ProposalID)
+}
+
+// FinalVotes is a collection of FinalVote objects
+type FinalVotes []FinalVote
+
+func (v FinalVotes) String() string {
+	if len(v) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Final votes for Proposal %d:", v[0].ProposalID)
+	for _, vot := range v {
+		out += fmt.Sprintf("\n  %s: %s (%d)", vot.Voter.String(), vot.Option, vot.VotingPower)
+	}
+	return out
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/gov/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 // AddVote Adds a vote on a specific proposal
@@ -82,3 +83,44 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voter hmType
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voter))
 }
+
+// IsVoteArchiveActive returns true if the votes are archived when their proposal is tallied at the ctx height
+func (keeper Keeper) IsVoteArchiveActive(ctx sdk.Context) bool {
+	return keeper.uk.IsUpgradeActive(ctx, upgradeTypes.VoteArchive)
+}
+
+// GetAllFinalVotes returns all the archived votes from the store
+func (keeper Keeper) GetAllFinalVotes(ctx sdk.Context) (votes types.FinalVotes) {
+	keeper.IterateAllFinalVotes(ctx, func(vote types.FinalVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
+// GetFinalVotes returns the votes archived when a proposal was tallied
+func (keeper Keeper) GetFinalVotes(ctx sdk.Context, proposalID uint64) (votes types.FinalVotes) {
+	keeper.IterateFinalVotes(ctx, proposalID, func(vote types.FinalVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
+// GetFinalVote gets the archived vote of a validator on a specific proposal
+func (keeper Keeper) GetFinalVote(ctx sdk.Context, proposalID uint64, voter hmTypes.ValidatorID) (vote types.FinalVote, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.FinalVoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
+	return vote, true
+}
+
+func (keeper Keeper) setFinalVote(ctx sdk.Context, vote types.FinalVote) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(vote)
+	store.Set(types.FinalVoteKey(vote.ProposalID, vote.Voter), bz)
+}
@@ -119,6 +119,12 @@ type ContractCaller struct {
 
 	MaticChainTimeout time.Duration
 
+	// SideTxQuorum is the number of endpoints which must agree on side tx verification reads
+	SideTxQuorum            int
+	MainChainQuorumClients  []*ethclient.Client
+	MaticChainQuorumClients []*ethclient.Client
+	MaticGrpcQuorumClients  []*grpc.BorGRPCClient
+
 	RootChainABI     abi.ABI
 	StakingInfoABI   abi.ABI
 	ValidatorSetABI  abi.ABI
@@ -155,6 +161,10 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.ReceiptCache, err = lru.New(1000)
 	contractCallerObj.MaticGrpcFlag = config.BorGRPCFlag
 	contractCallerObj.MaticGrpcClient = GetMaticGRPCClient()
+	contractCallerObj.SideTxQuorum = config.SideTxRPCQuorum
+	contractCallerObj.MainChainQuorumClients = GetMainQuorumClients()
+	contractCallerObj.MaticChainQuorumClients = GetMaticQuorumClients()
+	contractCallerObj.MaticGrpcQuorumClients = GetMaticGRPCQuorumClients()
 
 	if err != nil {
 		return contractCallerObj, err
@@ -318,7 +328,9 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 	var err error
 
 	// Both MainChainClient and MaticChainClient cannot be nil, check it while initializing
-	if c.MaticGrpcFlag {
+	if c.quorumEnabled() {
+		rootHash, err = c.getQuorumRootHash(ctx, start, end)
+	} else if c.MaticGrpcFlag {
 		rootHash, err = c.MaticGrpcClient.GetRootHash(ctx, start, end)
 	} else {
 		rootHash, err = c.MaticChainClient.GetRootHash(ctx, start, end)
@@ -344,7 +356,9 @@ func (c *ContractCaller) GetVoteOnHash(start uint64, end uint64, milestoneLength
 	var vote bool
 	var err error
 
-	if c.MaticGrpcFlag {
+	if c.quorumEnabled() {
+		vote, err = c.getQuorumVoteOnHash(ctx, start, end, hash, milestoneID)
+	} else if c.MaticGrpcFlag {
 		vote, err = c.MaticGrpcClient.GetVoteOnHash(ctx, start, end, hash, milestoneID)
 	} else {
 		vote, err = c.MaticChainClient.GetVoteOnHash(ctx, start, end, hash, milestoneID)
@@ -581,7 +595,12 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 		var err error
 
 		// get main tx receipt
-		receipt, err = c.GetMainTxReceipt(tx)
+		if c.quorumEnabled() {
+			receipt, err = c.getQuorumMainTxReceipt(tx)
+		} else {
+			receipt, err = c.GetMainTxReceipt(tx)
+		}
+
 		if err != nil {
 			Logger.Error("Error while fetching mainChain receipt", "txHash", tx.Hex(), "error", err)
 			return nil, err
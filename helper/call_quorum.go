@@ -0,0 +1,97 @@
+package helper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// quorumEnabled returns true if side tx verification reads need several endpoints to agree
+func (c *ContractCaller) quorumEnabled() bool {
+	return c.SideTxQuorum > 1
+}
+
+// maticQuorumClientsLen returns the number of bor endpoints used for quorum reads
+func (c *ContractCaller) maticQuorumClientsLen() int {
+	if c.MaticGrpcFlag {
+		return len(c.MaticGrpcQuorumClients)
+	}
+
+	return len(c.MaticChainQuorumClients)
+}
+
+// getQuorumRootHash returns the root hash agreed on by the bor endpoints
+func (c *ContractCaller) getQuorumRootHash(ctx context.Context, start uint64, end uint64) (string, error) {
+	rootHash, err := quorumRead(c.maticQuorumClientsLen(), c.SideTxQuorum, func(i int) (interface{}, string, error) {
+		var (
+			rootHash string
+			err      error
+		)
+
+		if c.MaticGrpcFlag {
+			rootHash, err = c.MaticGrpcQuorumClients[i].GetRootHash(ctx, start, end)
+		} else {
+			rootHash, err = c.MaticChainQuorumClients[i].GetRootHash(ctx, start, end)
+		}
+
+		return rootHash, rootHash, err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rootHash.(string), nil
+}
+
+// getQuorumVoteOnHash returns the vote agreed on by the bor endpoints
+func (c *ContractCaller) getQuorumVoteOnHash(ctx context.Context, start uint64, end uint64, hash string, milestoneID string) (bool, error) {
+	vote, err := quorumRead(c.maticQuorumClientsLen(), c.SideTxQuorum, func(i int) (interface{}, string, error) {
+		var (
+			vote bool
+			err  error
+		)
+
+		if c.MaticGrpcFlag {
+			vote, err = c.MaticGrpcQuorumClients[i].GetVoteOnHash(ctx, start, end, hash, milestoneID)
+		} else {
+			vote, err = c.MaticChainQuorumClients[i].GetVoteOnHash(ctx, start, end, hash, milestoneID)
+		}
+
+		return vote, strconv.FormatBool(vote), err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return vote.(bool), nil
+}
+
+// getQuorumMainTxReceipt returns the main chain receipt agreed on by the eth endpoints
+func (c *ContractCaller) getQuorumMainTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+	defer cancel()
+
+	receipt, err := quorumRead(len(c.MainChainQuorumClients), c.SideTxQuorum, func(i int) (interface{}, string, error) {
+		receipt, err := c.MainChainQuorumClients[i].TransactionReceipt(ctx, txHash)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// consensus fields and inclusion block
+		receiptBytes, err := receipt.MarshalBinary()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return receipt, receipt.BlockHash.Hex() + hex.EncodeToString(receiptBytes), nil
+	})
+	if err != nil {
+		Logger.Error("Main chain endpoints did not agree on receipt", "txHash", txHash.Hex(), "error", err)
+		return nil, err
+	}
+
+	return receipt.(*ethTypes.Receipt), nil
+}
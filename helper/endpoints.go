@@ -0,0 +1,305 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	borgrpc "github.com/maticnetwork/heimdall/bor/client/grpc"
+)
+
+const (
+	// endpointCooldown is how long a failing endpoint is skipped before it is tried again
+	endpointCooldown = 30 * time.Second
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on the result of a quorum read
+var ErrNoQuorum = errors.New("endpoints did not reach quorum")
+
+// SplitEndpoints returns the endpoints of a comma separated list
+func SplitEndpoints(endpoints string) []string {
+	result := make([]string, 0)
+
+	for _, endpoint := range strings.Split(endpoints, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			result = append(result, endpoint)
+		}
+	}
+
+	return result
+}
+
+// endpointHealth tracks failures of an endpoint
+type endpointHealth struct {
+	url                 *url.URL
+	consecutiveFailures int
+	unhealthyUntil      time.Time
+}
+
+// failoverTransport sends JSON-RPC requests to the first healthy endpoint, in configured
+// order, and fails over to the next one on connection errors and 5xx/429 responses
+type failoverTransport struct {
+	mu        sync.Mutex
+	endpoints []*endpointHealth
+	cooldown  time.Duration
+	base      http.RoundTripper
+}
+
+// newFailoverTransport creates a failover transport for http(s) endpoints
+func newFailoverTransport(endpoints []string, cooldown time.Duration) (*failoverTransport, error) {
+	transport := &failoverTransport{
+		endpoints: make([]*endpointHealth, 0, len(endpoints)),
+		cooldown:  cooldown,
+		base:      http.DefaultTransport,
+	}
+
+	for _, endpoint := range endpoints {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+			return nil, fmt.Errorf("failover is only supported for http(s) endpoints, got %s", endpointURL.Scheme)
+		}
+
+		transport.endpoints = append(transport.endpoints, &endpointHealth{url: endpointURL})
+	}
+
+	return transport, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil && req.GetBody == nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+
+		_ = req.Body.Close()
+	}
+
+	var (
+		resp    *http.Response
+		lastErr error
+	)
+
+	for _, endpoint := range t.order() {
+		// only the response of the last attempt is returned when every endpoint fails
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		attempt := req.Clone(req.Context())
+		attempt.URL = endpointRequestURL(endpoint.url)
+		attempt.Host = ""
+
+		// credentials of one endpoint must not be sent to the others
+		attempt.Header.Del("Authorization")
+
+		if endpoint.url.User != nil {
+			password, _ := endpoint.url.User.Password()
+			attempt.SetBasicAuth(endpoint.url.User.Username(), password)
+		}
+
+		switch {
+		case req.GetBody != nil:
+			reqBody, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attempt.Body = reqBody
+		case body != nil:
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, lastErr = t.base.RoundTrip(attempt)
+		if lastErr == nil && resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+			t.markHealthy(endpoint)
+			return resp, nil
+		}
+
+		// the request was cancelled by the caller, the endpoint is not at fault
+		if req.Context().Err() != nil {
+			return resp, lastErr
+		}
+
+		t.markFailed(endpoint, resp, lastErr)
+	}
+
+	return resp, lastErr
+}
+
+// order returns healthy endpoints in configured order followed by the unhealthy ones,
+// so that requests are still attempted when every endpoint is failing
+func (t *failoverTransport) order() []*endpointHealth {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]*endpointHealth, 0, len(t.endpoints))
+	unhealthy := make([]*endpointHealth, 0)
+
+	for _, endpoint := range t.endpoints {
+		if now.Before(endpoint.unhealthyUntil) {
+			unhealthy = append(unhealthy, endpoint)
+		} else {
+			healthy = append(healthy, endpoint)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (t *failoverTransport) markHealthy(endpoint *endpointHealth) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if endpoint.consecutiveFailures > 0 {
+		Logger.Info("RPC endpoint recovered", "endpoint", endpoint.url.Host)
+	}
+
+	endpoint.consecutiveFailures = 0
+	endpoint.unhealthyUntil = time.Time{}
+}
+
+func (t *failoverTransport) markFailed(endpoint *endpointHealth, resp *http.Response, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	endpoint.consecutiveFailures++
+	endpoint.unhealthyUntil = time.Now().Add(t.cooldown)
+
+	if err == nil {
+		err = fmt.Errorf("status %s", resp.Status)
+	}
+
+	Logger.Error("RPC endpoint failed, failing over", "endpoint", endpoint.url.Host, "consecutiveFailures", endpoint.consecutiveFailures, "error", err)
+}
+
+// endpointRequestURL returns the endpoint url without credentials
+func endpointRequestURL(endpointURL *url.URL) *url.URL {
+	requestURL := *endpointURL
+	requestURL.User = nil
+
+	return &requestURL
+}
+
+// dialEndpoints dials a rpc client for a comma separated list of endpoints. A single endpoint
+// is dialed directly, several http(s) endpoints share a client failing over between them.
+func dialEndpoints(endpoints string) (*rpc.Client, error) {
+	urls := SplitEndpoints(endpoints)
+
+	switch len(urls) {
+	case 0:
+		return nil, errors.New("no endpoint configured")
+	case 1:
+		return rpc.Dial(urls[0])
+	}
+
+	transport, err := newFailoverTransport(urls, endpointCooldown)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpc.DialOptions(context.Background(), urls[0], rpc.WithHTTPClient(&http.Client{Transport: transport}))
+}
+
+// dialQuorumClients dials an eth client per endpoint for quorum reads
+func dialQuorumClients(endpoints string, quorum int) ([]*ethclient.Client, error) {
+	urls := SplitEndpoints(endpoints)
+	if quorum > len(urls) {
+		return nil, fmt.Errorf("quorum %d exceeds the %d configured endpoints", quorum, len(urls))
+	}
+
+	clients := make([]*ethclient.Client, 0, len(urls))
+
+	for _, endpoint := range urls {
+		rpcClient, err := rpc.Dial(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		clients = append(clients, ethclient.NewClient(rpcClient))
+	}
+
+	return clients, nil
+}
+
+// newGRPCQuorumClients creates a bor gRPC client per endpoint for quorum reads
+func newGRPCQuorumClients(endpoints string, quorum int) ([]*borgrpc.BorGRPCClient, error) {
+	urls := SplitEndpoints(endpoints)
+	if quorum > len(urls) {
+		return nil, fmt.Errorf("quorum %d exceeds the %d configured endpoints", quorum, len(urls))
+	}
+
+	clients := make([]*borgrpc.BorGRPCClient, 0, len(urls))
+
+	for _, endpoint := range urls {
+		clients = append(clients, borgrpc.NewBorGRPCClient(endpoint))
+	}
+
+	return clients, nil
+}
+
+// quorumRead calls read for each of n endpoints concurrently and returns the value which at
+// least quorum of them agree on, values are compared using the key returned by read
+func quorumRead(n int, quorum int, read func(i int) (value interface{}, key string, err error)) (interface{}, error) {
+	type result struct {
+		value interface{}
+		key   string
+		err   error
+	}
+
+	results := make([]result, n)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			value, key, err := read(i)
+			results[i] = result{value: value, key: key, err: err}
+		}(i)
+	}
+
+	wg.Wait()
+
+	votes := make(map[string]int)
+	errs := make([]string, 0)
+
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err.Error())
+			continue
+		}
+
+		votes[r.key]++
+		if votes[r.key] >= quorum {
+			return r.value, nil
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %d of %d required, errors: %s", ErrNoQuorum, quorum, n, strings.Join(errs, "; "))
+	}
+
+	return nil, fmt.Errorf("%w: %d of %d required", ErrNoQuorum, quorum, n)
+}
@@ -0,0 +1,121 @@
+package helper
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitEndpoints(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"http://a:8545"}, SplitEndpoints("http://a:8545"))
+	require.Equal(t, []string{"http://a:8545", "http://b:8545"}, SplitEndpoints(" http://a:8545 , http://b:8545,"))
+	require.Empty(t, SplitEndpoints(""))
+}
+
+func TestFailoverTransport(t *testing.T) {
+	t.Parallel()
+
+	var primaryCalls, secondaryCalls atomic.Int32
+
+	primaryDown := atomic.Bool{}
+	primaryDown.Store(true)
+
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+
+		if primaryDown.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		_, _ = w.Write([]byte("primary"))
+	}))
+	defer primary.Close()
+
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secondaryCalls.Add(1)
+
+		// the request body is replayed on failover
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, "request", string(body))
+		require.Empty(t, r.Header.Get("Authorization"))
+
+		_, _ = w.Write([]byte("secondary"))
+	}))
+	defer secondary.Close()
+
+	transport, err := newFailoverTransport([]string{"http://user:pass@" + strings.TrimPrefix(primary.URL, "http://"), secondary.URL}, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: transport}
+
+	post := func() string {
+		req, err := http.NewRequest(http.MethodPost, primary.URL, io.NopCloser(strings.NewReader("request")))
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return string(body)
+	}
+
+	// primary fails, request goes to secondary
+	require.Equal(t, "secondary", post())
+	require.Equal(t, int32(1), primaryCalls.Load())
+
+	// primary is skipped while cooling down
+	require.Equal(t, "secondary", post())
+	require.Equal(t, int32(1), primaryCalls.Load())
+
+	// primary is used again once it recovers
+	primaryDown.Store(false)
+	time.Sleep(100 * time.Millisecond)
+
+	require.Equal(t, "primary", post())
+	require.Equal(t, int32(2), secondaryCalls.Load())
+}
+
+func TestFailoverTransportRejectsWebsocket(t *testing.T) {
+	t.Parallel()
+
+	_, err := newFailoverTransport([]string{"http://a:8545", "ws://b:8546"}, time.Second)
+	require.Error(t, err)
+}
+
+func TestQuorumRead(t *testing.T) {
+	t.Parallel()
+
+	read := func(values []string) func(i int) (interface{}, string, error) {
+		return func(i int) (interface{}, string, error) {
+			if values[i] == "" {
+				return nil, "", errors.New("endpoint down")
+			}
+
+			return values[i], values[i], nil
+		}
+	}
+
+	value, err := quorumRead(3, 2, read([]string{"0xaa", "", "0xaa"}))
+	require.NoError(t, err)
+	require.Equal(t, "0xaa", value)
+
+	_, err = quorumRead(3, 2, read([]string{"0xaa", "0xbb", ""}))
+	require.ErrorIs(t, err, ErrNoQuorum)
+
+	_, err = quorumRead(3, 3, read([]string{"0xaa", "0xaa", "0xbb"}))
+	require.ErrorIs(t, err, ErrNoQuorum)
+}
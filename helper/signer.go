@@ -0,0 +1,293 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const (
+	// LocalSigner signs with the key in priv_validator_key.json
+	LocalSigner = "local"
+	// KeystoreSigner signs with the key of an encrypted keystore file
+	KeystoreSigner = "keystore"
+	// RemoteSigner signs with a web3signer compatible remote signer
+	RemoteSigner = "remote"
+
+	// remoteSignerTimeout is the timeout of a remote signer request
+	remoteSignerTimeout = 10 * time.Second
+)
+
+// Signer signs heimdall messages and ethereum transactions with the validator key
+type Signer interface {
+	// PubKey returns the validator public key
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// Sign signs the keccak256 hash of data, the signature is in [R || S || V] format where V is 0 or 1
+	Sign(data []byte) ([]byte, error)
+
+	// SignTx signs an ethereum transaction for chainID
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// NewSigner creates the signer selected in the configuration, privKey is used by the local signer
+func NewSigner(conf Configuration, privKey secp256k1.PrivKeySecp256k1) (Signer, error) {
+	switch conf.Signer {
+	case "", LocalSigner:
+		return NewPrivKeySigner(privKey), nil
+	case KeystoreSigner:
+		return NewKeystoreSigner(conf.SignerKeystoreFile, conf.SignerPasswordFile)
+	case RemoteSigner:
+		return NewRemoteSigner(conf.SignerURL, conf.SignerPubKey)
+	default:
+		return nil, fmt.Errorf("unknown signer %s, expected %s, %s or %s", conf.Signer, LocalSigner, KeystoreSigner, RemoteSigner)
+	}
+}
+
+//
+// Private key signer
+//
+
+type privKeySigner struct {
+	privKey secp256k1.PrivKeySecp256k1
+	pubKey  secp256k1.PubKeySecp256k1
+}
+
+// NewPrivKeySigner creates a signer from a private key held in memory
+func NewPrivKeySigner(privKey secp256k1.PrivKeySecp256k1) Signer {
+	var pubKey secp256k1.PubKeySecp256k1
+	if pk, ok := privKey.PubKey().(secp256k1.PubKeySecp256k1); ok {
+		pubKey = pk
+	}
+
+	return &privKeySigner{privKey: privKey, pubKey: pubKey}
+}
+
+func (s *privKeySigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+func (s *privKeySigner) Sign(data []byte) ([]byte, error) {
+	ecdsaPrivateKey, err := ethCrypto.ToECDSA(s.privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return ethCrypto.Sign(ethCrypto.Keccak256(data), ecdsaPrivateKey)
+}
+
+func (s *privKeySigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ecdsaPrivateKey, err := ethCrypto.ToECDSA(s.privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), ecdsaPrivateKey)
+}
+
+//
+// Keystore signer
+//
+
+// NewKeystoreSigner creates a signer from an encrypted keystore file, as generated by
+// `heimdallcli generate-keystore`, decrypted with the passphrase in passwordFile
+func NewKeystoreSigner(keystoreFile string, passwordFile string) (Signer, error) {
+	keyJSON, err := os.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keystore file: %w", err)
+	}
+
+	password, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keystore password file: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt keystore: %w", err)
+	}
+
+	var privKey secp256k1.PrivKeySecp256k1
+
+	copy(privKey[:], ethCrypto.FromECDSA(key.PrivateKey))
+
+	return NewPrivKeySigner(privKey), nil
+}
+
+//
+// Remote signer
+//
+
+// RemoteTxArgs are the arguments of the eth_signTransaction request sent to the remote signer
+type RemoteTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+type remoteSigner struct {
+	url        string
+	pubKey     secp256k1.PubKeySecp256k1
+	address    common.Address
+	rpcClient  *rpc.Client
+	httpClient *http.Client
+}
+
+// NewRemoteSigner creates a signer for a web3signer compatible remote signer holding the key of pubKey.
+// Transactions are signed with eth_signTransaction and heimdall messages with the eth1 sign endpoint.
+func NewRemoteSigner(url string, pubKey string) (Signer, error) {
+	pubKeyBytes, err := hexutil.Decode(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer public key: %w", err)
+	}
+
+	ecdsaPubKey, err := ethCrypto.UnmarshalPubkey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer public key, expected uncompressed secp256k1 key: %w", err)
+	}
+
+	rpcClient, err := rpc.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &remoteSigner{
+		url:        strings.TrimRight(url, "/"),
+		address:    ethCrypto.PubkeyToAddress(*ecdsaPubKey),
+		rpcClient:  rpcClient,
+		httpClient: &http.Client{Timeout: remoteSignerTimeout},
+	}
+
+	copy(signer.pubKey[:], pubKeyBytes)
+
+	return signer, nil
+}
+
+func (s *remoteSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+func (s *remoteSigner) Sign(data []byte) ([]byte, error) {
+	body, err := json.Marshal(map[string]hexutil.Bytes{"data": data})
+	if err != nil {
+		return nil, err
+	}
+
+	// identifier is the public key without the 0x04 prefix
+	endpoint := fmt.Sprintf("%s/api/v1/eth1/sign/%s", s.url, hexutil.Encode(s.pubKey[1:]))
+
+	resp, err := s.httpClient.Post(endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(respBody)), `"`))
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+
+	if len(sig) != ethCrypto.SignatureLength {
+		return nil, fmt.Errorf("invalid remote signer signature length %d", len(sig))
+	}
+
+	// signers may return V as 27 or 28
+	if sig[ethCrypto.RecoveryIDOffset] >= 27 {
+		sig[ethCrypto.RecoveryIDOffset] -= 27
+	}
+
+	// make sure the remote signer used the configured key
+	recovered, err := ethCrypto.Ecrecover(ethCrypto.Keccak256(data), sig)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(recovered, s.pubKey[:]) {
+		return nil, errors.New("remote signer signed with a different key")
+	}
+
+	return sig, nil
+}
+
+func (s *remoteSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := RemoteTxArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	var raw hexutil.Bytes
+	if err := s.rpcClient.CallContext(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, err
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("invalid remote signer transaction: %w", err)
+	}
+
+	txSigner := types.LatestSignerForChainID(chainID)
+
+	// make sure the remote signer did not change the transaction
+	if txSigner.Hash(signedTx) != txSigner.Hash(tx) {
+		return nil, errors.New("remote signer returned a different transaction")
+	}
+
+	from, err := types.Sender(txSigner, signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if from != s.address {
+		return nil, fmt.Errorf("remote signer signed with %s instead of %s", from.Hex(), s.address.Hex())
+	}
+
+	return signedTx, nil
+}
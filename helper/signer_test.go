@@ -0,0 +1,224 @@
+package helper
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// testSignerAPI implements eth_signTransaction of a remote signer
+type testSignerAPI struct {
+	key *ecdsa.PrivateKey
+}
+
+func (api *testSignerAPI) SignTransaction(args RemoteTxArgs) (hexutil.Bytes, error) {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   args.ChainID.ToInt(),
+		Nonce:     uint64(args.Nonce),
+		GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap: args.MaxFeePerGas.ToInt(),
+		Gas:       uint64(args.Gas),
+		To:        args.To,
+		Value:     args.Value.ToInt(),
+		Data:      args.Data,
+	})
+
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), api.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx.MarshalBinary()
+}
+
+// newTestRemoteSigner starts a web3signer like server signing with key
+func newTestRemoteSigner(t *testing.T, key *ecdsa.PrivateKey) *httptest.Server {
+	t.Helper()
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", &testSignerAPI{key: key}))
+
+	mux := http.NewServeMux()
+	mux.Handle("/", rpcServer)
+	mux.HandleFunc("/api/v1/eth1/sign/", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data hexutil.Bytes `json:"data"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		sig, err := ethCrypto.Sign(ethCrypto.Keccak256(body.Data), key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// web3signer returns V as 27 or 28
+		sig[ethCrypto.RecoveryIDOffset] += 27
+
+		_, _ = w.Write([]byte(hexutil.Encode(sig)))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func privKeyFromECDSA(key *ecdsa.PrivateKey) secp256k1.PrivKeySecp256k1 {
+	var privKey secp256k1.PrivKeySecp256k1
+
+	copy(privKey[:], ethCrypto.FromECDSA(key))
+
+	return privKey
+}
+
+// requireSigner checks the signatures of signer are made with key
+func requireSigner(t *testing.T, signer Signer, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	pubKey := signer.PubKey()
+	require.Equal(t, ethCrypto.FromECDSAPub(&key.PublicKey), pubKey[:])
+
+	data := []byte("heimdall sign bytes")
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+
+	expected, err := ethCrypto.Sign(ethCrypto.Keccak256(data), key)
+	require.NoError(t, err)
+	require.Equal(t, expected, sig)
+
+	chainID := big.NewInt(1)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	signedTx, err := signer.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      []byte{0x01},
+	}), chainID)
+	require.NoError(t, err)
+
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	require.NoError(t, err)
+	require.Equal(t, ethCrypto.PubkeyToAddress(key.PublicKey), from)
+	require.Equal(t, uint64(7), signedTx.Nonce())
+}
+
+func TestPrivKeySigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	requireSigner(t, NewPrivKeySigner(privKeyFromECDSA(key)), key)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    ethCrypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keystoreFile := filepath.Join(dir, "keystore.json")
+	passwordFile := filepath.Join(dir, "password")
+
+	require.NoError(t, os.WriteFile(keystoreFile, keyJSON, 0600))
+	require.NoError(t, os.WriteFile(passwordFile, []byte("passphrase\n"), 0600))
+
+	signer, err := NewKeystoreSigner(keystoreFile, passwordFile)
+	require.NoError(t, err)
+
+	requireSigner(t, signer, key)
+
+	require.NoError(t, os.WriteFile(passwordFile, []byte("wrong"), 0600))
+
+	_, err = NewKeystoreSigner(keystoreFile, passwordFile)
+	require.Error(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	server := newTestRemoteSigner(t, key)
+
+	signer, err := NewRemoteSigner(server.URL, hexutil.Encode(ethCrypto.FromECDSAPub(&key.PublicKey)))
+	require.NoError(t, err)
+
+	requireSigner(t, signer, key)
+}
+
+func TestRemoteSignerWrongKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	otherKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	server := newTestRemoteSigner(t, otherKey)
+
+	signer, err := NewRemoteSigner(server.URL, hexutil.Encode(ethCrypto.FromECDSAPub(&key.PublicKey)))
+	require.NoError(t, err)
+
+	_, err = signer.Sign([]byte("data"))
+	require.Error(t, err)
+
+	chainID := big.NewInt(1)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	_, err = signer.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(0),
+	}), chainID)
+	require.ErrorContains(t, err, "remote signer signed with")
+}
+
+func TestNewSignerUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSigner(Configuration{Signer: "hsm"}, secp256k1.PrivKeySecp256k1{})
+	require.Error(t, err)
+}
@@ -14,18 +14,21 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 ##### RPC and REST configs #####
 
-# RPC endpoint for ethereum chain
+# RPC endpoint for ethereum chain, comma separated list for failover
 eth_rpc_url = "{{ .EthRPCUrl }}"
 
-# RPC endpoint for bor chain
+# RPC endpoint for bor chain, comma separated list for failover
 bor_rpc_url = "{{ .BorRPCUrl }}"
 
 # GRPC flag for bor chain
 bor_grpc_flag = "{{ .BorGRPCFlag }}"
 
-# GRPC endpoint for bor chain
+# GRPC endpoint for bor chain, comma separated list for failover
 bor_grpc_url = "{{ .BorGRPCUrl }}"
 
+# Number of ethereum/bor endpoints which must agree when verifying side txs (0 or 1 disables)
+side_tx_rpc_quorum = "{{ .SideTxRPCQuorum }}"
+
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
@@ -37,9 +40,28 @@ sub_graph_url = "{{ .SubGraphUrl }}"
 # Heimdall REST server endpoint, which is used by bridge
 heimdall_rest_server = "{{ .HeimdallServerURL }}"
 
+# API key sent to the heimdall REST server, if it requires authentication
+heimdall_rest_api_key = "{{ .HeimdallServerAPIKey }}"
+
+# Client certificate and key sent to the heimdall REST server, if it requires mTLS. The node server
+# certificate is used if empty and server_tls_client_ca_file is set
+heimdall_rest_tls_cert_file = "{{ .HeimdallServerTLSCertFile }}"
+heimdall_rest_tls_key_file = "{{ .HeimdallServerTLSKeyFile }}"
+# CA of the heimdall REST server certificate, the system CAs if empty
+heimdall_rest_tls_ca_file = "{{ .HeimdallServerTLSCAFile }}"
+
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Bridge task queue backend: "amqp" (RabbitMQ at amqp_url) or "embedded" (bridge db)
+task_queue_backend = "{{ .TaskQueueBackend }}"
+
+# Heimdall listener mode: "poll" (query every block) or "subscribe" (tendermint event bus, polls while disconnected)
+heimdall_listener_mode = "{{ .HeimdallListenerMode }}"
+
+# Listen address of the bridge status api (listener cursors, task queue and processor health)
+bridge_status_addr = "{{ .BridgeStatusAddr }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -51,6 +73,8 @@ enable_self_heal = "{{ .EnableSH }}"
 sh_state_synced_interval = "{{ .SHStateSyncedInterval }}"
 sh_stake_update_interval = "{{ .SHStakeUpdateInterval }}"
 sh_max_depth_duration = "{{ .SHMaxDepthDuration }}"
+sh_log_scan_window = "{{ .SHLogScanWindow }}"
+sh_log_scan_max_blocks = "{{ .SHLogScanMaxBlocks }}"
 
 
 #### gas limits ####
@@ -58,10 +82,49 @@ main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 
 #### gas price ####
 main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
+main_chain_tx_bump_interval = "{{ .MainchainTxBumpInterval }}"
+main_chain_tx_fee_bump_percent = "{{ .MainchainTxFeeBumpPercent }}"
+
+#### validator key signer: local, keystore or remote ####
+signer = "{{ .Signer }}"
+signer_keystore_file = "{{ .SignerKeystoreFile }}"
+signer_password_file = "{{ .SignerPasswordFile }}"
+signer_url = "{{ .SignerURL }}"
+signer_public_key = "{{ .SignerPubKey }}"
 
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+##### Node configs #####
+# Keep every milestone in the milestone archive db (data/milestones.db) for historical queries
+milestone_archive = "{{ .MilestoneArchive }}"
+# Keep the checkpoints lifecycle events and proposers stats in the checkpoint history db (data/checkpoint_history.db)
+checkpoint_history = "{{ .CheckpointHistory }}"
+
+##### REST and gRPC servers security #####
+# Server certificate and key, enable TLS
+server_tls_cert_file = "{{ .ServerTLSCertFile }}"
+server_tls_key_file = "{{ .ServerTLSKeyFile }}"
+# CA of the client certificates, enables mTLS
+server_tls_client_ca_file = "{{ .ServerTLSClientCAFile }}"
+# Comma separated API keys (X-API-Key header, x-api-key gRPC metadata), authentication is required if set
+server_api_keys = "{{ .ServerAPIKeys }}"
+# HMAC secret of the HS256 JWT bearer tokens, authentication is required if set
+server_jwt_secret = "{{ .ServerJWTSecret }}"
+# Requests per second per client (API key, token subject or IP), 0 disables
+server_rate_limit = "{{ .ServerRateLimit }}"
+server_rate_limit_burst = "{{ .ServerRateLimitBurst }}"
+# Comma separated REST paths and gRPC methods also limited by server_expensive_rate_limit, 0 disables
+server_expensive_routes = "{{ .ServerExpensiveRoutes }}"
+server_expensive_rate_limit = "{{ .ServerExpensiveRateLimit }}"
+
+##### REST server cache #####
+# Cache the responses of the cached routes until the next block, with ETags (stats at /cache/stats)
+rest_cache = "{{ .RESTCache }}"
+# Comma separated cached REST paths, a trailing * matching any path with the prefix
+rest_cache_routes = "{{ .RESTCacheRoutes }}"
+rest_cache_max_entries = "{{ .RESTCacheMaxEntries }}"
+
 ##### chain - newSelectionAlgoHeight depends on this #####
 chain = "{{ .Chain }}"
 `
@@ -13,7 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/maticnetwork/heimdall/contracts/erc20"
@@ -29,17 +29,8 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
-
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
+	fromAddress := GetFromAddress()
 	// fetch gas price
 	gasprice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
@@ -76,10 +67,16 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	}
 
 	// create auth
-	auth, err = bind.NewKeyedTransactorWithChainID(ecdsaPrivateKey, chainId)
-	if err != nil {
-		Logger.Error("Unable to create auth object", "error", err)
-		return
+	auth = &bind.TransactOpts{
+		From: fromAddress,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != fromAddress {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			return SignMainchainTx(tx, chainId)
+		},
+		Context: context.Background(),
 	}
 
 	if nonce > uint64(math.MaxInt64) {
@@ -93,6 +90,16 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	return
 }
 
+// GetFromAddress returns the eth address of the validator key
+func GetFromAddress() common.Address {
+	return common.BytesToAddress(GetAddress())
+}
+
+// SignMainchainTx signs a mainchain transaction with the validator signer
+func SignMainchainTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return GetSigner().SignTx(tx, chainID)
+}
+
 // SendCheckpoint sends checkpoint to rootchain contract
 // todo return err
 func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (er error) {
@@ -3,6 +3,8 @@ package helper
 import (
 	"bufio"
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
@@ -76,6 +78,73 @@ func init() {
 	Client = &http.Client{}
 }
 
+// apiKeyTransport adds the API key of the heimdall server to the requests
+type apiKeyTransport struct {
+	apiKey string
+	base   http.RoundTripper
+}
+
+func (t *apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("X-API-Key", t.apiKey)
+
+	return t.base.RoundTrip(req)
+}
+
+// NewHeimdallHTTPClient returns an http client sending apiKey, if not empty, to the heimdall
+// server and connecting with tlsConfig, if not nil
+func NewHeimdallHTTPClient(apiKey string, tlsConfig *tls.Config) *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+
+	if tlsConfig != nil {
+		base := http.DefaultTransport.(*http.Transport).Clone()
+		base.TLSClientConfig = tlsConfig
+		transport = base
+	}
+
+	if apiKey != "" {
+		transport = &apiKeyTransport{apiKey: apiKey, base: transport}
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// NewHeimdallTLSConfig returns the tls config of the requests to the heimdall server, with the client
+// certificate of certFile and keyFile and trusting the server certificates of caFile. It returns nil
+// if neither is given
+func NewHeimdallTLSConfig(certFile string, keyFile string, caFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read server CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in server CA %s", caFile)
+		}
+
+		config.RootCAs = pool
+	}
+
+	return config, nil
+}
+
 // Paginate returns the correct starting and ending index for a paginated query,
 // given that client provides a desired page and limit of objects and the handler
 // provides the total number of objects. If the start page is invalid, non-positive
@@ -371,7 +440,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext,
 			return nil, nil
 		}
 		txBldr = txBldr.WithChainID(testOpts[0].chainId)
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	txBldr, err := PrepareTxBuilder(cliCtx, txBldr)
@@ -381,7 +450,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext,
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	if !cliCtx.SkipConfirm {
@@ -428,7 +497,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithSigner(GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -563,7 +632,7 @@ func SignStdTx(cliCtx context.CLIContext, stdTx authTypes.StdTx, appendSig bool,
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	return txBldr.SignStdTxWithSigner(GetSigner(), stdTx, appendSig)
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
@@ -18,6 +18,14 @@ type Keeper struct {
 	tkey      sdk.StoreKey
 	codespace sdk.CodespaceType
 	spaces    map[string]*subspace.Subspace
+
+	validations map[string][]func() ValidatedParamSet
+}
+
+// ValidatedParamSet is a param set validated as a whole by the param change proposals
+type ValidatedParamSet interface {
+	subspace.ParamSet
+	Validate() error
 }
 
 // NewKeeper constructs a params keeper
@@ -28,6 +36,8 @@ func NewKeeper(cdc *codec.Codec, key *sdk.KVStoreKey, tkey *sdk.TransientStoreKe
 		tkey:      tkey,
 		codespace: codespace,
 		spaces:    make(map[string]*subspace.Subspace),
+
+		validations: make(map[string][]func() ValidatedParamSet),
 	}
 
 	return k
@@ -63,3 +73,53 @@ func (k Keeper) GetSubspace(s string) (subspace.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// RegisterValidation registers a param set of a subspace, returned with its default values by
+// newParamSet, to validate after a param change proposal updating any of its keys
+func (k Keeper) RegisterValidation(s string, newParamSet func() ValidatedParamSet) {
+	if _, ok := k.spaces[s]; !ok {
+		panic("subspace not allocated")
+	}
+
+	k.validations[s] = append(k.validations[s], newParamSet)
+}
+
+// ValidateParamSets validates the param sets of a subspace updated by the given keys, with
+// their stored values and the default ones for the keys not stored yet
+func (k Keeper) ValidateParamSets(ctx sdk.Context, s string, keys []string) error {
+	space, ok := k.GetSubspace(s)
+	if !ok {
+		return nil
+	}
+
+	for _, newParamSet := range k.validations[s] {
+		params := newParamSet()
+		pairs := params.ParamSetPairs()
+
+		if !hasAnyKey(pairs, keys) {
+			continue
+		}
+
+		for _, pair := range pairs {
+			space.GetIfExists(ctx, pair.Key, pair.Value)
+		}
+
+		if err := params.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasAnyKey(pairs subspace.ParamSetPairs, keys []string) bool {
+	for _, pair := range pairs {
+		for _, key := range keys {
+			if string(pair.Key) == key {
+				return true
+			}
+		}
+	}
+
+	return false
+}
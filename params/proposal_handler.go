@@ -23,6 +23,10 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterChangeProposal) sdk.Error {
+	var spaces []string
+
+	keys := make(map[string][]string)
+
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
@@ -36,6 +40,19 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterC
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return types.ErrSettingParameter(k.codespace, c.Key, c.Value, err.Error())
 		}
+
+		if _, ok := keys[c.Subspace]; !ok {
+			spaces = append(spaces, c.Subspace)
+		}
+
+		keys[c.Subspace] = append(keys[c.Subspace], c.Key)
+	}
+
+	// the params are validated once all the changes are set, as they may depend on each other
+	for _, space := range spaces {
+		if err := k.ValidateParamSets(ctx, space, keys[space]); err != nil {
+			return types.ErrInvalidParams(k.codespace, space, err.Error())
+		}
 	}
 
 	return nil
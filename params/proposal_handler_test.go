@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -149,3 +150,43 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+type validatedTestParams struct {
+	testParams
+}
+
+func (tp *validatedTestParams) Validate() error {
+	if tp.MaxValidators == 0 {
+		return errors.New("MaxValidators should be greater than zero")
+	}
+
+	return nil
+}
+
+func TestProposalHandlerValidation(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		subspace.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	input.keeper.RegisterValidation(testSubspace, func() params.ValidatedParamSet {
+		return &validatedTestParams{testParams{MaxValidators: 10}}
+	})
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// the keys not stored yet are validated with their default values
+	tp := testProposal(paramTypes.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	tp = testProposal(paramTypes.NewParamChange(testSubspace, keyMaxValidators, "5"))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	// a proposal leaving invalid params is rejected, its changes are dropped with the cache context
+	cacheCtx, _ := input.ctx.CacheContext()
+	tp = testProposal(paramTypes.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.Error(t, hdlr(cacheCtx, tp))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(5), param)
+}
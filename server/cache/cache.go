@@ -0,0 +1,334 @@
+// Package cache implements a height aware cache of the REST query responses
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// StatsPath is the path of the cache statistics
+	StatsPath = "/cache/stats"
+
+	// maxEntryAge bounds the age of the entries, in case a new block event is missed
+	maxEntryAge = 30 * time.Second
+
+	// heightParam is the query param of the historical queries, which are not cached
+	heightParam = "height"
+)
+
+// Config is the configuration of the cache, from heimdall-config.toml
+type Config struct {
+	Routes     []string // cached paths, a trailing * matching any path with the prefix
+	MaxEntries int      // max number of cached responses
+}
+
+// Cache caches the successful GET responses of the configured routes for the latest block.
+// The node height is tracked with the new block events: the entries are dropped on every new
+// block, and nothing is cached before the first block is known
+type Cache struct {
+	routes     map[string]struct{}
+	prefixes   []string
+	maxEntries int
+
+	mu      sync.RWMutex
+	height  int64
+	entries map[string]*entry
+
+	group singleflight.Group
+
+	statsMu sync.Mutex
+	stats   map[string]*RouteStats
+}
+
+// entry is a cached response
+type entry struct {
+	status  int
+	header  http.Header
+	body    []byte
+	etag    string
+	height  int64
+	created time.Time
+}
+
+// RouteStats are the hits and misses of a cached route
+type RouteStats struct {
+	Hits        uint64 `json:"hits"`
+	Misses      uint64 `json:"misses"`
+	NotModified uint64 `json:"not_modified"`
+}
+
+// New returns a cache of the responses of config routes
+func New(config Config) *Cache {
+	c := &Cache{
+		routes:     make(map[string]struct{}),
+		maxEntries: config.MaxEntries,
+		entries:    make(map[string]*entry),
+		stats:      make(map[string]*RouteStats),
+	}
+
+	for _, route := range config.Routes {
+		if prefix := strings.TrimSuffix(route, "*"); prefix != route {
+			c.prefixes = append(c.prefixes, prefix)
+		} else {
+			c.routes[route] = struct{}{}
+		}
+	}
+
+	return c
+}
+
+// SetHeight sets the latest block height of the node, dropping the entries of older heights
+func (c *Cache) SetHeight(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height == c.height {
+		return
+	}
+
+	c.height = height
+	c.entries = make(map[string]*entry)
+}
+
+// route returns the cached route matching path, false if path is not cached
+func (c *Cache) route(path string) (string, bool) {
+	if _, ok := c.routes[path]; ok {
+		return path, true
+	}
+
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return prefix + "*", true
+		}
+	}
+
+	return "", false
+}
+
+// get returns the entry of key valid at the latest height, and the latest height
+func (c *Cache) get(key string) (*entry, int64) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	if !ok || e.height != c.height || time.Since(e.created) > maxEntryAge {
+		return nil, c.height
+	}
+
+	return e, c.height
+}
+
+// put caches e, unless the height moved on while it was computed or the cache is full
+func (c *Cache) put(key string, e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e.height != c.height {
+		return
+	}
+
+	if _, ok := c.entries[key]; !ok && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		return
+	}
+
+	c.entries[key] = e
+}
+
+// Handler serves the cached responses of the configured routes, and the cache statistics
+func (c *Cache) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == StatsPath && r.Method == http.MethodGet {
+			c.serveStats(w)
+			return
+		}
+
+		route, ok := c.route(r.URL.Path)
+		if !ok || r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		if query.Get(heightParam) != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key := r.URL.Path + "?" + query.Encode()
+
+		e, height := c.get(key)
+		if e != nil {
+			c.record(route, func(s *RouteStats) { s.Hits++ })
+			c.write(w, r, route, e, "HIT")
+
+			return
+		}
+
+		if height == 0 {
+			// the height is not known yet
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		c.record(route, func(s *RouteStats) { s.Misses++ })
+
+		// concurrent misses of the same response share a single query
+		v, _, _ := c.group.Do(fmt.Sprintf("%d:%s", height, key), func() (interface{}, error) {
+			rec := newRecorder()
+			next.ServeHTTP(rec, r)
+
+			e := &entry{
+				status:  rec.status,
+				header:  rec.header,
+				body:    rec.body.Bytes(),
+				height:  height,
+				created: time.Now(),
+			}
+
+			if e.status == http.StatusOK {
+				hash := sha256.Sum256(e.body)
+				e.etag = `"` + hex.EncodeToString(hash[:16]) + `"`
+
+				c.put(key, e)
+			}
+
+			return e, nil
+		})
+
+		c.write(w, r, route, v.(*entry), "MISS")
+	})
+}
+
+// write writes a response, or 304 if it matches the If-None-Match header of the request
+func (c *Cache) write(w http.ResponseWriter, r *http.Request, route string, e *entry, cacheStatus string) {
+	for k, values := range e.header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+
+	w.Header().Set("X-Cache", cacheStatus)
+
+	if e.etag != "" {
+		w.Header().Set("ETag", e.etag)
+
+		if matchETag(r.Header.Get("If-None-Match"), e.etag) {
+			c.record(route, func(s *RouteStats) { s.NotModified++ })
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+	}
+
+	w.WriteHeader(e.status)
+	_, _ = w.Write(e.body)
+}
+
+// matchETag checks if an If-None-Match header matches etag
+func matchETag(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Cache) record(route string, update func(*RouteStats)) {
+	c.statsMu.Lock()
+	defer c.statsMu.Unlock()
+
+	s, ok := c.stats[route]
+	if !ok {
+		s = &RouteStats{}
+		c.stats[route] = s
+	}
+
+	update(s)
+}
+
+// Stats are the cache statistics served at StatsPath
+type Stats struct {
+	Height   int64                 `json:"height"`
+	Entries  int                   `json:"entries"`
+	Hits     uint64                `json:"hits"`
+	Misses   uint64                `json:"misses"`
+	HitRatio float64               `json:"hit_ratio"`
+	Routes   map[string]RouteStats `json:"routes"`
+}
+
+// Stats returns the cache statistics
+func (c *Cache) Stats() Stats {
+	c.mu.RLock()
+	stats := Stats{
+		Height:  c.height,
+		Entries: len(c.entries),
+		Routes:  make(map[string]RouteStats),
+	}
+	c.mu.RUnlock()
+
+	c.statsMu.Lock()
+	for route, s := range c.stats {
+		stats.Routes[route] = *s
+		stats.Hits += s.Hits
+		stats.Misses += s.Misses
+	}
+	c.statsMu.Unlock()
+
+	if total := stats.Hits + stats.Misses; total != 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(total)
+	}
+
+	return stats
+}
+
+func (c *Cache) serveStats(w http.ResponseWriter) {
+	bz, err := json.Marshal(c.Stats())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// recorder records a response to cache it
+type recorder struct {
+	status int
+	header http.Header
+	body   bytes.Buffer
+	wrote  bool
+}
+
+func newRecorder() *recorder {
+	return &recorder{status: http.StatusOK, header: make(http.Header)}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if !r.wrote {
+		r.status = status
+		r.wrote = true
+	}
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wrote = true
+	return r.body.Write(b)
+}
@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// countingHandler answers with the number of requests it served
+func countingHandler(served *int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(served, 1)
+
+		if r.URL.Path == "/checkpoints/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"height":"1","result":%d}`, n)
+	})
+}
+
+func serve(handler http.Handler, path string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestCacheHandler(t *testing.T) {
+	t.Parallel()
+
+	var served int64
+
+	c := New(Config{Routes: []string{"/checkpoints/latest", "/checkpoints/missing", "/bor/span/*"}, MaxEntries: 10})
+	handler := c.Handler(countingHandler(&served))
+
+	// bypassed until the height is known
+	serve(handler, "/checkpoints/latest", nil)
+	serve(handler, "/checkpoints/latest", nil)
+	require.Equal(t, int64(2), served)
+
+	c.SetHeight(10)
+
+	first := serve(handler, "/checkpoints/latest", nil)
+	require.Equal(t, "MISS", first.Header().Get("X-Cache"))
+	require.Equal(t, "application/json", first.Header().Get("Content-Type"))
+
+	second := serve(handler, "/checkpoints/latest", nil)
+	require.Equal(t, "HIT", second.Header().Get("X-Cache"))
+	require.Equal(t, first.Body.String(), second.Body.String())
+	require.Equal(t, int64(3), served)
+
+	// etag
+	etag := second.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	notModified := serve(handler, "/checkpoints/latest", http.Header{"If-None-Match": []string{etag}})
+	require.Equal(t, http.StatusNotModified, notModified.Code)
+	require.Empty(t, notModified.Body.String())
+
+	// new block
+	c.SetHeight(11)
+
+	third := serve(handler, "/checkpoints/latest", http.Header{"If-None-Match": []string{etag}})
+	require.Equal(t, http.StatusOK, third.Code)
+	require.NotEqual(t, first.Body.String(), third.Body.String())
+	require.Equal(t, int64(4), served)
+
+	// query params are part of the key, historical queries are not cached
+	serve(handler, "/bor/span/2", nil)
+	serve(handler, "/bor/span/2", nil)
+	serve(handler, "/bor/span/3", nil)
+	serve(handler, "/bor/span/3?height=5", nil)
+	serve(handler, "/bor/span/3?height=5", nil)
+	require.Equal(t, int64(8), served)
+
+	// errors are not cached, other routes are not cached
+	serve(handler, "/checkpoints/missing", nil)
+	serve(handler, "/checkpoints/missing", nil)
+	serve(handler, "/checkpoints/count", nil)
+	serve(handler, "/checkpoints/count", nil)
+	require.Equal(t, int64(12), served)
+
+	stats := c.Stats()
+	require.Equal(t, int64(11), stats.Height)
+	require.Equal(t, uint64(3), stats.Hits)
+	require.Equal(t, uint64(6), stats.Misses)
+	require.Equal(t, uint64(1), stats.Routes["/checkpoints/latest"].NotModified)
+	require.Equal(t, uint64(1), stats.Routes["/bor/span/*"].Hits)
+	require.InDelta(t, 3.0/9.0, stats.HitRatio, 1e-9)
+
+	rec := serve(handler, StatsPath, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"hit_ratio"`)
+}
+
+func TestCacheMaxEntries(t *testing.T) {
+	t.Parallel()
+
+	var served int64
+
+	c := New(Config{Routes: []string{"/bor/span/*"}, MaxEntries: 1})
+	handler := c.Handler(countingHandler(&served))
+	c.SetHeight(1)
+
+	serve(handler, "/bor/span/1", nil)
+	serve(handler, "/bor/span/2", nil)
+	serve(handler, "/bor/span/1", nil)
+	serve(handler, "/bor/span/2", nil)
+	require.Equal(t, int64(3), served)
+	require.Equal(t, 1, c.Stats().Entries)
+}
+
+func TestCacheConcurrentMisses(t *testing.T) {
+	t.Parallel()
+
+	var served int64
+
+	release := make(chan struct{})
+	c := New(Config{Routes: []string{"/checkpoints/latest"}})
+	handler := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		atomic.AddInt64(&served, 1)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	c.SetHeight(1)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			rec := serve(handler, "/checkpoints/latest", nil)
+			require.Equal(t, "{}", rec.Body.String())
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	// the requests joining the first one share its query, the later ones hit the cache
+	require.Equal(t, int64(1), served)
+}
+
+func TestCacheFollowEvents(t *testing.T) {
+	t.Parallel()
+
+	c := New(Config{Routes: []string{"/checkpoints/*"}})
+	events := make(chan ctypes.ResultEvent, 2)
+
+	events <- ctypes.ResultEvent{Data: tmTypes.EventDataNewBlock{Block: &tmTypes.Block{Header: tmTypes.Header{Height: 10}}}}
+	events <- ctypes.ResultEvent{Data: tmTypes.EventDataNewBlock{Block: &tmTypes.Block{Header: tmTypes.Header{Height: 11}}}}
+
+	// the events stop, as on a dropped websocket connection
+	start := time.Now()
+
+	err := c.followEvents(context.Background(), events, 50*time.Millisecond)
+	require.ErrorIs(t, err, errBlockTimeout)
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.Equal(t, int64(11), c.Stats().Height)
+
+	// done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, c.followEvents(ctx, events, time.Hour))
+}
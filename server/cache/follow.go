@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// blockSubscriber is the tendermint subscriber of the new blocks invalidating the cache
+	blockSubscriber = "rest-cache"
+
+	// resubscribeInterval is the time between the attempts to subscribe to the new blocks
+	resubscribeInterval = 5 * time.Second
+
+	// blockTimeout is the time without new block after which the subscription is considered broken.
+	// The websocket client never closes the events channel, a dropped connection stops the events
+	blockTimeout = time.Minute
+)
+
+// errBlockTimeout is returned when no new block is received within blockTimeout
+var errBlockTimeout = errors.New("no new block received within timeout")
+
+// Follow tracks the height of the node at nodeURI with its new block events until ctx is done.
+// The cache is bypassed while the node cannot be subscribed to, or sends no new block
+func (c *Cache) Follow(ctx context.Context, nodeURI string, logger log.Logger) {
+	for {
+		if err := c.follow(ctx, nodeURI); err != nil {
+			logger.Error("Error while subscribing to new blocks, bypassing the cache", "error", err)
+		}
+
+		c.SetHeight(0)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resubscribeInterval):
+		}
+	}
+}
+
+func (c *Cache) follow(ctx context.Context, nodeURI string) error {
+	client := httpClient.NewHTTP(nodeURI, "/websocket")
+	if err := client.Start(); err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = client.Stop()
+	}()
+
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+
+	events, err := client.Subscribe(ctx, blockSubscriber, query)
+	if err != nil {
+		return err
+	}
+
+	return c.followEvents(ctx, events, blockTimeout)
+}
+
+// followEvents sets the height of the new block events until ctx is done, or no new block is
+// received within timeout
+func (c *Cache) followEvents(ctx context.Context, events <-chan ctypes.ResultEvent, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			return errBlockTimeout
+		case event := <-events:
+			data, ok := event.Data.(tmTypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+
+			c.SetHeight(data.Block.Height)
+
+			if !timer.Stop() {
+				<-timer.C
+			}
+
+			timer.Reset(timeout)
+		}
+	}
+}
@@ -9,8 +9,9 @@ import (
 	"time"
 
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/maticnetwork/heimdall/helper"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
 
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	protoutils "github.com/maticnetwork/polyproto/utils"
@@ -30,16 +31,17 @@ type Checkpoint struct {
 func (h *HeimdallGRPCServer) FetchCheckpointCount(_ context.Context, _ *emptypb.Empty) (*proto.FetchCheckpointCountResponse, error) {
 	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchCheckpointCount))
+	result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryAckCount, nil)
 	if err != nil {
-		logger.Error("Error while fetching checkpoint count")
+		logger.Error("Error while fetching checkpoint count", "error", err)
 		return nil, err
 	}
 
 	resp := &proto.FetchCheckpointCountResponse{}
 	resp.Height = fmt.Sprint(result.Height)
+	resp.Result = &proto.CheckpointCount{}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
+	if err := json.Unmarshal(result.Result, &resp.Result.Result); err != nil {
 		logger.Error("Error unmarshalling checkpoint count", "error", err)
 		return nil, err
 	}
@@ -50,20 +52,42 @@ func (h *HeimdallGRPCServer) FetchCheckpointCount(_ context.Context, _ *emptypb.
 func (h *HeimdallGRPCServer) FetchCheckpoint(_ context.Context, in *proto.FetchCheckpointRequest) (*proto.FetchCheckpointResponse, error) {
 	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 
-	url := ""
+	var number uint64
+
 	if in.ID == -1 {
-		url = fmt.Sprintf(fetchCheckpoint, "latest")
+		// the latest checkpoint is the last acked one
+		result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryAckCount, nil)
+		if err != nil {
+			logger.Error("Error while fetching checkpoint count", "error", err)
+			return nil, err
+		}
+
+		if err := json.Unmarshal(result.Result, &number); err != nil {
+			logger.Error("Error unmarshalling checkpoint count", "error", err)
+			return nil, err
+		}
+
+		// the checkpoint is queried at the height of the count
+		cliCtx = cliCtx.WithHeight(result.Height)
 	} else {
-		url = fmt.Sprintf(fetchCheckpoint, fmt.Sprint(in.ID))
-	}
+		if in.ID < 0 {
+			return nil, fmt.Errorf("invalid checkpoint number %d", in.ID)
+		}
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(url))
+		//nolint:gosec
+		number = uint64(in.ID)
+	}
 
+	result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpoint, checkpointTypes.NewQueryCheckpointParams(number))
 	if err != nil {
-		logger.Error("Error while fetching checkpoint")
+		logger.Error("Error while fetching checkpoint", "number", number, "error", err)
 		return nil, err
 	}
 
+	return toFetchCheckpointResponse(result)
+}
+
+func toFetchCheckpointResponse(result rest.ResponseWithHeight) (*proto.FetchCheckpointResponse, error) {
 	checkPoint := &Checkpoint{}
 	if err := json.Unmarshal(result.Result, &checkPoint); err != nil {
 		logger.Error("Error unmarshalling checkpoint", "error", err)
@@ -0,0 +1,67 @@
+package gRPC
+
+import (
+	"context"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	proto "github.com/maticnetwork/polyproto/heimdall"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// CheckpointIndexServer serves the checkpoints covering bor blocks.
+// The service is not part of polyproto, it reuses its checkpoint messages
+type CheckpointIndexServer interface {
+	// FetchCheckpointByBorBlock returns the acked checkpoint covering a bor block
+	FetchCheckpointByBorBlock(context.Context, *wrapperspb.UInt64Value) (*proto.FetchCheckpointResponse, error)
+}
+
+// CheckpointIndexServiceDesc is the grpc service description of CheckpointIndexServer
+var CheckpointIndexServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.CheckpointIndex",
+	HandlerType: (*CheckpointIndexServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchCheckpointByBorBlock",
+			Handler:    fetchCheckpointByBorBlockHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: extensionsFileName,
+}
+
+func fetchCheckpointByBorBlockHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(CheckpointIndexServer).FetchCheckpointByBorBlock(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.CheckpointIndex/FetchCheckpointByBorBlock",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckpointIndexServer).FetchCheckpointByBorBlock(ctx, req.(*wrapperspb.UInt64Value))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func (h *HeimdallGRPCServer) FetchCheckpointByBorBlock(ctx context.Context, in *wrapperspb.UInt64Value) (*proto.FetchCheckpointResponse, error) {
+	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+
+	result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpointByBlock, checkpointTypes.NewQueryBorBlockParams(in.GetValue()))
+	if err != nil {
+		logger.Error("Error while fetching checkpoint by bor block", "block", in.GetValue(), "error", err)
+		return nil, err
+	}
+
+	return toFetchCheckpointResponse(result)
+}
@@ -0,0 +1,113 @@
+package gRPC
+
+import (
+	"context"
+	"encoding/json"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointProofServer serves the inclusion proofs of bor blocks in checkpoints.
+// The service is not part of polyproto, the proof is returned as a struct with the REST fields
+type CheckpointProofServer interface {
+	// FetchCheckpointProof returns the inclusion proof of a bor block in its checkpoint
+	FetchCheckpointProof(context.Context, *wrapperspb.UInt64Value) (*structpb.Struct, error)
+}
+
+// CheckpointProofServiceDesc is the grpc service description of CheckpointProofServer
+var CheckpointProofServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.CheckpointProof",
+	HandlerType: (*CheckpointProofServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchCheckpointProof",
+			Handler:    fetchCheckpointProofHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: extensionsFileName,
+}
+
+func fetchCheckpointProofHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(CheckpointProofServer).FetchCheckpointProof(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.CheckpointProof/FetchCheckpointProof",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckpointProofServer).FetchCheckpointProof(ctx, req.(*wrapperspb.UInt64Value))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func (h *HeimdallGRPCServer) FetchCheckpointProof(ctx context.Context, in *wrapperspb.UInt64Value) (*structpb.Struct, error) {
+	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+
+	// the checkpoint covering the block
+	result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpointByBlock, checkpointTypes.NewQueryBorBlockParams(in.GetValue()))
+	if err != nil {
+		logger.Error("Error while fetching checkpoint by bor block", "block", in.GetValue(), "error", err)
+		return nil, err
+	}
+
+	var checkpoint hmTypes.CheckpointWithID
+	if err := json.Unmarshal(result.Result, &checkpoint); err != nil {
+		logger.Error("Error unmarshalling checkpoint", "error", err)
+		return nil, err
+	}
+
+	// the params at the height of the checkpoint
+	result, err = queryWithHeight(cliCtx.WithHeight(result.Height), checkpointTypes.QuerierRoute, checkpointTypes.QueryParams, nil)
+	if err != nil {
+		logger.Error("Error while fetching checkpoint params", "error", err)
+		return nil, err
+	}
+
+	var params checkpointTypes.Params
+	if err := json.Unmarshal(result.Result, &params); err != nil {
+		logger.Error("Error unmarshalling checkpoint params", "error", err)
+		return nil, err
+	}
+
+	contractCaller, err := helper.NewContractCaller()
+	if err != nil {
+		logger.Error("Error while creating contract caller", "error", err)
+		return nil, err
+	}
+
+	borBlockProof, err := checkpointTypes.NewBorBlockProof(contractCaller, checkpoint, in.GetValue(), params.ChildBlockInterval)
+	if err != nil {
+		logger.Error("Error while building checkpoint proof", "block", in.GetValue(), "checkpoint", checkpoint.ID, "error", err)
+		return nil, err
+	}
+
+	bz, err := json.Marshal(borBlockProof)
+	if err != nil {
+		return nil, err
+	}
+
+	var proof map[string]interface{}
+	if err := json.Unmarshal(bz, &proof); err != nil {
+		logger.Error("Error unmarshalling checkpoint proof", "error", err)
+		return nil, err
+	}
+
+	return structpb.NewStruct(proof)
+}
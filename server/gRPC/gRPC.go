@@ -5,6 +5,7 @@ import (
 	"net"
 	"time"
 
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	tmLog "github.com/tendermint/tendermint/libs/log"
@@ -14,31 +15,45 @@ import (
 )
 
 const (
-	spanURL                 = "/bor/span/%v"
-	eventRecordList         = "/clerk/event-record/list"
-	fetchCheckpointCount    = "/checkpoints/count"
-	fetchCheckpoint         = "/checkpoints/%s"
-	fetchMilestoneCount     = "/milestone/count"
-	fetchMilestone          = "/milestone/latest"
-	fetchMilestoneNoAck     = "/milestone/noAck/%s"
-	fetchLastNoAckMilestone = "/milestone/lastNoAck"
-	fetchMilestoneID        = "/milestone/ID/%s"
+	spanURL         = "/bor/span/%v"
+	eventRecordList = "/clerk/event-record/list"
 )
 
 var logger tmLog.Logger
 
 type HeimdallGRPCServer struct {
 	proto.UnimplementedHeimdallServer
-	cdc *codec.Codec
+	cdc    *codec.Codec
+	blocks blockSource
 }
 
-func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string, lggr tmLog.Logger) error {
+// SetupGRPCServer starts the gRPC server on addr, opts adding the security options of the server
+func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string, lggr tmLog.Logger, opts ...grpc.ServerOption) error {
 	logger = lggr
-	grpcServer := grpc.NewServer(withLoggingUnaryInterceptor())
-	proto.RegisterHeimdallServer(grpcServer,
-		&HeimdallGRPCServer{
-			cdc: cdc,
-		})
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{withLoggingUnaryInterceptor()}, opts...)...)
+	server := &HeimdallGRPCServer{
+		cdc:    cdc,
+		blocks: newBlockNotifier(cliContext.NewCLIContext().NodeURI),
+	}
+
+	proto.RegisterHeimdallServer(grpcServer, server)
+	grpcServer.RegisterService(&MilestoneArchiveServiceDesc, server)
+	grpcServer.RegisterService(&CheckpointProofServiceDesc, server)
+	grpcServer.RegisterService(&CheckpointIndexServiceDesc, server)
+	grpcServer.RegisterService(&SubscriptionServiceDesc, server)
+
+	for _, service := range queryServices {
+		desc, err := service.serviceDesc()
+		if err != nil {
+			return err
+		}
+
+		grpcServer.RegisterService(desc, server)
+	}
+
+	if err := registerReflection(grpcServer); err != nil {
+		return err
+	}
 
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -52,6 +67,7 @@ func SetupGRPCServer(shutDownCtx context.Context, cdc *codec.Codec, addr string,
 
 		<-shutDownCtx.Done()
 		grpcServer.Stop()
+		server.blocks.stop()
 		lis.Close()
 		logger.Info("GRPC Server stopped", "addr", addr)
 	}()
@@ -69,7 +85,8 @@ func loggingServerInterceptor(ctx context.Context, req interface{}, info *grpc.U
 	start := time.Now()
 
 	h, err := handler(ctx, req)
-	if err != nil {
+	if _, ok := status.FromError(err); !ok {
+		// keep the codes of the status errors, such as the authentication and rate limit ones
 		err = status.Error(codes.Internal, err.Error())
 	}
 
@@ -3,14 +3,17 @@ package gRPC
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"time"
 
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
 
 	proto "github.com/maticnetwork/polyproto/heimdall"
 	protoutils "github.com/maticnetwork/polyproto/utils"
@@ -30,16 +33,17 @@ type Milestone struct {
 func (h *HeimdallGRPCServer) FetchMilestoneCount(ctx context.Context, in *emptypb.Empty) (*proto.FetchMilestoneCountResponse, error) {
 	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchMilestoneCount))
+	result, err := queryMilestone(cliCtx, checkpointTypes.QueryCount, nil)
 	if err != nil {
-		logger.Error("Error while fetching checkpoint count")
+		logger.Error("Error while fetching milestone count", "error", err)
 		return nil, err
 	}
 
 	resp := &proto.FetchMilestoneCountResponse{}
 	resp.Height = fmt.Sprint(result.Height)
+	resp.Result = &proto.MilestoneCount{}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
+	if err := json.Unmarshal(result.Result, &resp.Result.Count); err != nil {
 		logger.Error("Error unmarshalling milestone count", "error", err)
 		return nil, err
 	}
@@ -50,13 +54,32 @@ func (h *HeimdallGRPCServer) FetchMilestoneCount(ctx context.Context, in *emptyp
 func (h *HeimdallGRPCServer) FetchMilestone(ctx context.Context, in *emptypb.Empty) (*proto.FetchMilestoneResponse, error) {
 	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchMilestone))
-
+	result, err := queryMilestone(cliCtx, checkpointTypes.QueryLatestMilestone, nil)
 	if err != nil {
-		logger.Error("Error while fetching milestone")
+		logger.Error("Error while fetching milestone", "error", err)
 		return nil, err
 	}
 
+	return toFetchMilestoneResponse(result)
+}
+
+// queryMilestone queries path of the checkpoint querier, failing before the Aalborg hard fork
+// which introduced the milestones
+func queryMilestone(cliCtx cliContext.CLIContext, path string, params interface{}) (rest.ResponseWithHeight, error) {
+	result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, path, params)
+	if err != nil {
+		return rest.ResponseWithHeight{}, err
+	}
+
+	if result.Height < helper.GetAalborgHardForkHeight() {
+		return rest.ResponseWithHeight{}, errors.New("aalborg hardfork not activated yet")
+	}
+
+	return result, nil
+}
+
+// toFetchMilestoneResponse converts a milestone queried from the checkpoint querier
+func toFetchMilestoneResponse(result rest.ResponseWithHeight) (*proto.FetchMilestoneResponse, error) {
 	milestone := &Milestone{}
 	if err := json.Unmarshal(result.Result, &milestone); err != nil {
 		logger.Error("Error unmarshalling milestone", "error", err)
@@ -93,17 +116,17 @@ func (h *HeimdallGRPCServer) FetchMilestone(ctx context.Context, in *emptypb.Emp
 func (h *HeimdallGRPCServer) FetchLastNoAckMilestone(ctx context.Context, in *emptypb.Empty) (*proto.FetchLastNoAckMilestoneResponse, error) {
 	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fetchLastNoAckMilestone))
-
+	result, err := queryMilestone(cliCtx, checkpointTypes.QueryLatestNoAckMilestone, nil)
 	if err != nil {
-		logger.Error("Error while fetching milestone last no ack")
+		logger.Error("Error while fetching milestone last no ack", "error", err)
 		return nil, err
 	}
 
 	resp := &proto.FetchLastNoAckMilestoneResponse{}
 	resp.Height = fmt.Sprint(result.Height)
+	resp.Result = &proto.LastNoAckMilestone{}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
+	if err := json.Unmarshal(result.Result, &resp.Result.Result); err != nil {
 		logger.Error("Error unmarshalling milestone", "error", err)
 		return nil, err
 	}
@@ -114,19 +137,17 @@ func (h *HeimdallGRPCServer) FetchLastNoAckMilestone(ctx context.Context, in *em
 func (h *HeimdallGRPCServer) FetchNoAckMilestone(ctx context.Context, in *proto.FetchMilestoneNoAckRequest) (*proto.FetchMilestoneNoAckResponse, error) {
 	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
 
-	url := fmt.Sprintf(fetchMilestoneNoAck, fmt.Sprint(in.MilestoneID))
-
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(url))
-
+	result, err := queryMilestone(cliCtx, checkpointTypes.QueryNoAckMilestoneByID, checkpointTypes.NewQueryMilestoneID(in.MilestoneID))
 	if err != nil {
-		logger.Error("Error while fetching milestone no ack")
+		logger.Error("Error while fetching milestone no ack", "error", err)
 		return nil, err
 	}
 
 	resp := &proto.FetchMilestoneNoAckResponse{}
 	resp.Height = fmt.Sprint(result.Height)
+	resp.Result = &proto.MilestoneNoAck{}
 
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
+	if err := json.Unmarshal(result.Result, &resp.Result.Result); err != nil {
 		logger.Error("Error unmarshalling milestone", "error", err)
 		return nil, err
 	}
@@ -135,24 +156,9 @@ func (h *HeimdallGRPCServer) FetchNoAckMilestone(ctx context.Context, in *proto.
 }
 
 func (h *HeimdallGRPCServer) FetchMilestoneID(ctx context.Context, in *proto.FetchMilestoneIDRequest) (*proto.FetchMilestoneIDResponse, error) {
-	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
-
-	url := fmt.Sprintf(fetchMilestoneID, fmt.Sprint(in.MilestoneID))
-
-	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(url))
-
-	if err != nil {
-		logger.Error("Error while fetching milestone id")
-		return nil, err
-	}
-
-	resp := &proto.FetchMilestoneIDResponse{}
-	resp.Height = fmt.Sprint(result.Height)
-
-	if err := json.Unmarshal(result.Result, &resp.Result); err != nil {
-		logger.Error("Error unmarshalling milestone", "error", err)
-		return nil, err
-	}
-
-	return resp, nil
+	// the milestone id is proposed by this node, it is not part of the state
+	return &proto.FetchMilestoneIDResponse{
+		Height: "0",
+		Result: &proto.MilestoneID{Result: in.MilestoneID == checkpointTypes.GetMilestoneID()},
+	}, nil
 }
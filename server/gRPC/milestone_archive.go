@@ -0,0 +1,67 @@
+package gRPC
+
+import (
+	"context"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	proto "github.com/maticnetwork/polyproto/heimdall"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// MilestoneArchiveServer serves the milestones of the milestone archive.
+// The service is not part of polyproto, it reuses its milestone messages
+type MilestoneArchiveServer interface {
+	// FetchMilestoneByBorBlock returns the milestone which finalized a bor block
+	FetchMilestoneByBorBlock(context.Context, *wrapperspb.UInt64Value) (*proto.FetchMilestoneResponse, error)
+}
+
+// MilestoneArchiveServiceDesc is the grpc service description of MilestoneArchiveServer
+var MilestoneArchiveServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.MilestoneArchive",
+	HandlerType: (*MilestoneArchiveServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchMilestoneByBorBlock",
+			Handler:    fetchMilestoneByBorBlockHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: extensionsFileName,
+}
+
+func fetchMilestoneByBorBlockHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(MilestoneArchiveServer).FetchMilestoneByBorBlock(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.MilestoneArchive/FetchMilestoneByBorBlock",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneArchiveServer).FetchMilestoneByBorBlock(ctx, req.(*wrapperspb.UInt64Value))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func (h *HeimdallGRPCServer) FetchMilestoneByBorBlock(ctx context.Context, in *wrapperspb.UInt64Value) (*proto.FetchMilestoneResponse, error) {
+	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+
+	result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryMilestoneByBorBlock, checkpointTypes.NewQueryBorBlockParams(in.GetValue()))
+	if err != nil {
+		logger.Error("Error while fetching milestone by bor block", "block", in.GetValue(), "error", err)
+		return nil, err
+	}
+
+	return toFetchMilestoneResponse(result)
+}
@@ -0,0 +1,478 @@
+package gRPC
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// queryHeightField is the request field selecting the height of a query, latest if missing, and
+	// the response field with the height of the result
+	queryHeightField = "height"
+
+	// queryResultField is the response field with the querier result
+	queryResultField = "result"
+)
+
+// queryMethod is a method of a module query service, served by a path of the module querier
+type queryMethod struct {
+	name   string             // grpc method name
+	path   string             // querier path
+	params func() interface{} // new query params, nil if the query takes no params
+	result interface{}        // querier result, nil if it has no proto type
+}
+
+// queryService is the grpc query service of a module, querying the module querier of the app
+// directly instead of going through the REST server
+type queryService struct {
+	module  string // module name, the service is heimdall.<module>.Query
+	route   string // module querier route
+	methods []queryMethod
+}
+
+// QueryServer serves the module query services.
+// The services are not part of polyproto, their messages are described from the go types of the
+// querier params and results: the <Method>Request has an optional height and the querier params,
+// the <Method>Response has the height and the querier result. The messages are converted from and
+// to the json of the querier, the results without proto type are google.protobuf.Value
+type QueryServer interface {
+	Query(ctx context.Context, route string, path string, params interface{}, fields map[string]interface{}) ([]byte, int64, error)
+}
+
+// queryRequest is implemented by requests whose fields differ from the querier params
+type queryRequest interface {
+	queryParams() interface{}
+}
+
+// signerRequest takes the signer address as hex, as the REST server does, instead of
+// the base64 bytes of QuerySignerParams
+type signerRequest struct {
+	SignerAddress hmTypes.HeimdallAddress `json:"signer_address"`
+}
+
+func (r *signerRequest) queryParams() interface{} {
+	return stakingTypes.NewQuerySignerParams(r.SignerAddress.Bytes())
+}
+
+var queryServices = []queryService{
+	{
+		module: "staking",
+		route:  stakingTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"CurrentValidatorSet", stakingTypes.QueryCurrentValidatorSet, nil, hmTypes.ValidatorSet{}},
+			{"Signer", stakingTypes.QuerySigner, func() interface{} { return &signerRequest{} }, hmTypes.Validator{}},
+			{"Validator", stakingTypes.QueryValidator, func() interface{} { return &stakingTypes.QueryValidatorParams{} }, hmTypes.Validator{}},
+			{"ValidatorStatus", stakingTypes.QueryValidatorStatus, func() interface{} { return &signerRequest{} }, false},
+			{"Proposer", stakingTypes.QueryProposer, func() interface{} { return &stakingTypes.QueryProposerParams{} }, []hmTypes.Validator{}},
+			{"CurrentProposer", stakingTypes.QueryCurrentProposer, nil, hmTypes.Validator{}},
+			{"MilestoneProposer", stakingTypes.QueryMilestoneProposer, func() interface{} { return &stakingTypes.QueryProposerParams{} }, []hmTypes.Validator{}},
+			{"TotalValidatorPower", stakingTypes.QueryTotalValidatorPower, nil, int64(0)},
+			{"StakingSequence", stakingTypes.QueryStakingSequence, func() interface{} { return &stakingTypes.QueryStakingSequenceParams{} }, big.Int{}},
+		},
+	},
+	{
+		module: "topup",
+		route:  topupTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"Sequence", topupTypes.QuerySequence, func() interface{} { return &topupTypes.QuerySequenceParams{} }, big.Int{}},
+			{"DividendAccount", topupTypes.QueryDividendAccount, func() interface{} { return &topupTypes.QueryDividendAccountParams{} }, hmTypes.DividendAccount{}},
+			{"DividendAccountRoot", topupTypes.QueryDividendAccountRoot, nil, rawResult{}},
+			{"DividendAccountProof", topupTypes.QueryAccountProof, func() interface{} { return &topupTypes.QueryAccountProofParams{} }, hmTypes.DividendAccountProof{}},
+			{"VerifyAccountProof", topupTypes.QueryVerifyAccountProof, func() interface{} { return &topupTypes.QueryVerifyAccountProofParams{} }, false},
+		},
+	},
+	{
+		module: "slashing",
+		route:  slashingTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"Params", slashingTypes.QueryParameters, nil, slashingTypes.Params{}},
+			{"TickCount", slashingTypes.QueryTickCount, nil, uint64(0)},
+			{"SigningInfo", slashingTypes.QuerySigningInfo, func() interface{} { return &slashingTypes.QuerySigningInfoParams{} }, hmTypes.ValidatorSigningInfo{}},
+			{"SigningInfos", slashingTypes.QuerySigningInfos, func() interface{} { return &slashingTypes.QuerySigningInfosParams{} }, []hmTypes.ValidatorSigningInfo{}},
+			{"SlashingInfo", slashingTypes.QuerySlashingInfo, func() interface{} { return &slashingTypes.QuerySlashingInfoParams{} }, hmTypes.ValidatorSlashingInfo{}},
+			{"SlashingInfos", slashingTypes.QuerySlashingInfos, func() interface{} { return &slashingTypes.QuerySlashingInfosParams{} }, []hmTypes.ValidatorSlashingInfo{}},
+			{"SlashingInfoBytes", slashingTypes.QuerySlashingInfoBytes, nil, rawResult{}},
+			{"TickSlashingInfos", slashingTypes.QueryTickSlashingInfos, func() interface{} { return &slashingTypes.QueryTickSlashingInfosParams{} }, []hmTypes.ValidatorSlashingInfo{}},
+			{"SlashingSequence", slashingTypes.QuerySlashingSequence, func() interface{} { return &slashingTypes.QuerySlashingSequenceParams{} }, big.Int{}},
+		},
+	},
+	{
+		module: "clerk",
+		route:  clerkTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"Record", clerkTypes.QueryRecord, func() interface{} { return &clerkTypes.QueryRecordParams{} }, clerkTypes.EventRecord{}},
+			{"RecordList", clerkTypes.QueryRecordList, func() interface{} { return &hmTypes.QueryPaginationParams{} }, []clerkTypes.EventRecord{}},
+			{"RecordListWithTime", clerkTypes.QueryRecordListWithTime, func() interface{} { return &clerkTypes.QueryRecordTimePaginationParams{} }, []clerkTypes.EventRecord{}},
+			{"RecordSequence", clerkTypes.QueryRecordSequence, func() interface{} { return &clerkTypes.QueryRecordSequenceParams{} }, big.Int{}},
+		},
+	},
+	{
+		module: "chainmanager",
+		route:  chainmanagerTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"Params", chainmanagerTypes.QueryParams, nil, chainmanagerTypes.Params{}},
+		},
+	},
+	{
+		module: "gov",
+		route:  govTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"DepositParams", govTypes.QueryParams + "/" + govTypes.ParamDeposit, nil, govTypes.DepositParams{}},
+			{"VotingParams", govTypes.QueryParams + "/" + govTypes.ParamVoting, nil, govTypes.VotingParams{}},
+			{"TallyParams", govTypes.QueryParams + "/" + govTypes.ParamTallying, nil, govTypes.TallyParams{}},
+			{"Proposals", govTypes.QueryProposals, func() interface{} { return &govTypes.QueryProposalsParams{} }, []govTypes.Proposal{}},
+			{"Proposal", govTypes.QueryProposal, func() interface{} { return &govTypes.QueryProposalParams{} }, govTypes.Proposal{}},
+			{"Deposits", govTypes.QueryDeposits, func() interface{} { return &govTypes.QueryProposalParams{} }, govTypes.Deposits{}},
+			{"Deposit", govTypes.QueryDeposit, func() interface{} { return &govTypes.QueryDepositParams{} }, govTypes.Deposit{}},
+			{"Votes", govTypes.QueryVotes, func() interface{} { return &govTypes.QueryProposalParams{} }, govTypes.Votes{}},
+			{"Vote", govTypes.QueryVote, func() interface{} { return &govTypes.QueryVoteParams{} }, govTypes.Vote{}},
+			{"Tally", govTypes.QueryTally, func() interface{} { return &govTypes.QueryProposalParams{} }, govTypes.TallyResult{}},
+		},
+	},
+	{
+		module: "auth",
+		route:  authTypes.QuerierRoute,
+		methods: []queryMethod{
+			{"Params", authTypes.QueryParams, nil, authTypes.Params{}},
+			{"Account", authTypes.QueryAccount, func() interface{} { return &authTypes.QueryAccountParams{} }, nil},
+		},
+	},
+}
+
+// serviceName returns the full name of the grpc service
+func (s queryService) serviceName() string {
+	return fmt.Sprintf("heimdall.%s.Query", s.module)
+}
+
+// serviceDesc returns the grpc service description of the query service
+func (s queryService) serviceDesc() (*grpc.ServiceDesc, error) {
+	file, err := s.file()
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &grpc.ServiceDesc{
+		ServiceName: s.serviceName(),
+		HandlerType: (*QueryServer)(nil),
+		Methods:     make([]grpc.MethodDesc, 0, len(s.methods)),
+		Streams:     []grpc.StreamDesc{},
+		Metadata:    s.fileName(),
+	}
+
+	for _, method := range s.methods {
+		request := file.Messages().ByName(protoreflect.Name(method.name + "Request"))
+		response := file.Messages().ByName(protoreflect.Name(method.name + "Response"))
+
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: method.name,
+			Handler:    s.methodHandler(method, request, response),
+		})
+	}
+
+	return desc, nil
+}
+
+func (s queryService) methodHandler(method queryMethod, request, response protoreflect.MessageDescriptor) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	fullMethod := fmt.Sprintf("/%s/%s", s.serviceName(), method.name)
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := dynamicpb.NewMessage(request)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			var params interface{}
+			if method.params != nil {
+				params = method.params()
+			}
+
+			res, height, err := srv.(QueryServer).Query(ctx, s.route, method.path, params, messageFields(req.(*dynamicpb.Message)))
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := queryResponse(response, res, height, method.result)
+			if err != nil {
+				logger.Error("Error converting query result", "route", s.route, "path", method.path, "error", err)
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			return resp, nil
+		}
+
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: fullMethod,
+		}
+
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+// fileName returns the name of the proto file describing the query service
+func (s queryService) fileName() string {
+	return fmt.Sprintf("heimdall/%s/query.proto", s.module)
+}
+
+// file returns the descriptor of the proto file of the query service, registered once
+func (s queryService) file() (protoreflect.FileDescriptor, error) {
+	if err := registerFileDescriptor(s.fileDescriptor()); err != nil {
+		return nil, err
+	}
+
+	return protoregistry.GlobalFiles.FindFileByPath(s.fileName())
+}
+
+// fileDescriptor returns the proto file describing the query service and its messages
+func (s queryService) fileDescriptor() *descriptorpb.FileDescriptorProto {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       stringPtr(s.fileName()),
+		Package:    stringPtr(fmt.Sprintf("heimdall.%s", s.module)),
+		Dependency: []string{"google/protobuf/struct.proto"},
+		Syntax:     stringPtr("proto3"),
+	}
+
+	builder := newMessageBuilder(file)
+
+	// the names of the request and response messages are not used by the messages of the go types
+	for _, method := range s.methods {
+		builder.used[method.name+"Request"] = true
+		builder.used[method.name+"Response"] = true
+	}
+
+	service := &descriptorpb.ServiceDescriptorProto{
+		Name: stringPtr("Query"),
+	}
+
+	for _, method := range s.methods {
+		request := []*descriptorpb.FieldDescriptorProto{builder.field(queryHeightField, 1, reflect.TypeOf(int64(0)))}
+
+		if method.params != nil {
+			for _, f := range jsonFields(reflect.TypeOf(method.params()).Elem()) {
+				if f.name != queryHeightField {
+					request = append(request, builder.field(f.name, int32(len(request)+1), f.typ))
+				}
+			}
+		}
+
+		builder.addMessage(method.name+"Request", request)
+		builder.addMessage(method.name+"Response", []*descriptorpb.FieldDescriptorProto{
+			builder.field(queryHeightField, 1, reflect.TypeOf(int64(0))),
+			builder.field(queryResultField, 2, reflect.TypeOf(method.result)),
+		})
+
+		service.Method = append(service.Method, &descriptorpb.MethodDescriptorProto{
+			Name:       stringPtr(method.name),
+			InputType:  stringPtr(builder.typeName(method.name + "Request")),
+			OutputType: stringPtr(builder.typeName(method.name + "Response")),
+		})
+	}
+
+	file.Service = []*descriptorpb.ServiceDescriptorProto{service}
+
+	return file
+}
+
+// Query queries path of the module querier route with params filled from the request fields, and
+// returns the querier result with its height
+func (h *HeimdallGRPCServer) Query(_ context.Context, route string, path string, params interface{}, fields map[string]interface{}) ([]byte, int64, error) {
+	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+
+	if value, ok := fields[queryHeightField]; ok {
+		height, err := parseQueryHeight(value)
+		if err != nil {
+			return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		delete(fields, queryHeightField)
+
+		cliCtx = cliCtx.WithHeight(height)
+	}
+
+	var data []byte
+
+	if params != nil {
+		var err error
+		if data, err = h.queryData(fields, params); err != nil {
+			return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", route, path), data)
+	if err != nil {
+		logger.Error("Error while querying", "route", route, "path", path, "error", err)
+		return nil, 0, err
+	}
+
+	return res, height, nil
+}
+
+// queryData fills params from the request fields, which use the json names of the params as for
+// the REST server, and returns the querier data
+func (h *HeimdallGRPCServer) queryData(fields map[string]interface{}, params interface{}) ([]byte, error) {
+	bz, err := json.Marshal(integerFields(fields, params))
+	if err != nil {
+		return nil, fmt.Errorf("invalid query params: %w", err)
+	}
+
+	if err = json.Unmarshal(bz, params); err != nil {
+		return nil, fmt.Errorf("invalid query params: %w", err)
+	}
+
+	if request, ok := params.(queryRequest); ok {
+		params = request.queryParams()
+	}
+
+	return h.cdc.MarshalJSON(params)
+}
+
+// integerFields returns the request fields with the values of the integer fields of params as json
+// numbers: the integral doubles are written without exponent, and the strings are kept as they are so
+// that the integers beyond the precision of a double are exact
+func integerFields(fields map[string]interface{}, params interface{}) map[string]interface{} {
+	paramsType := reflect.TypeOf(params)
+	for paramsType.Kind() == reflect.Ptr {
+		paramsType = paramsType.Elem()
+	}
+
+	if paramsType.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < paramsType.NumField(); i++ {
+		field := paramsType.Field(i)
+
+		switch field.Type.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		} else if name == "" {
+			name = field.Name
+		}
+
+		// json matches the field names case insensitively
+		for key, value := range fields {
+			if !strings.EqualFold(key, name) {
+				continue
+			}
+
+			switch v := value.(type) {
+			case float64:
+				fields[key] = json.Number(strconv.FormatFloat(v, 'f', -1, 64))
+			case string:
+				fields[key] = json.Number(v)
+			}
+		}
+	}
+
+	return fields
+}
+
+// queryResponse returns the response message of a query with the querier result res of type result.
+// The json result is decoded with numbers, and set as the typed result field
+func queryResponse(desc protoreflect.MessageDescriptor, res []byte, height int64, result interface{}) (*dynamicpb.Message, error) {
+	resp := dynamicpb.NewMessage(desc)
+	resp.Set(desc.Fields().ByName(queryHeightField), protoreflect.ValueOfInt64(height))
+
+	if len(res) == 0 {
+		return resp, nil
+	}
+
+	resultField := desc.Fields().ByName(queryResultField)
+
+	if _, ok := result.(rawResult); ok {
+		resp.Set(resultField, protoreflect.ValueOfBytes(res))
+		return resp, nil
+	}
+
+	var value interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(res))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if value != nil {
+		if err := setField(resp, resultField, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// numbersToStrings replaces the json numbers of a decoded value by their string
+func numbersToStrings(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String()
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = numbersToStrings(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = numbersToStrings(item)
+		}
+	}
+
+	return value
+}
+
+// parseQueryHeight parses the height field of a request, a number or a numeric string
+func parseQueryHeight(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), nil
+	case string:
+		height, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid height %s: %w", v, err)
+		}
+
+		return height, nil
+	default:
+		return 0, fmt.Errorf("invalid height %v", value)
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
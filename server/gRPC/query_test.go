@@ -0,0 +1,235 @@
+package gRPC
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// testQueryServer records the query of the last request, and returns no result at height 42
+type testQueryServer struct {
+	route  string
+	path   string
+	params interface{}
+	fields map[string]interface{}
+}
+
+func (s *testQueryServer) Query(_ context.Context, route string, path string, params interface{}, fields map[string]interface{}) ([]byte, int64, error) {
+	s.route, s.path, s.params, s.fields = route, path, params, fields
+	return nil, 42, nil
+}
+
+// testQueryMethod returns the method of the query service of module, and its request and response messages
+func testQueryMethod(t *testing.T, module string, name string) (grpc.MethodDesc, protoreflect.MessageDescriptor, protoreflect.MessageDescriptor) {
+	t.Helper()
+
+	for _, service := range queryServices {
+		if service.module != module {
+			continue
+		}
+
+		desc, err := service.serviceDesc()
+		require.NoError(t, err)
+
+		file, err := service.file()
+		require.NoError(t, err)
+
+		for _, method := range desc.Methods {
+			if method.MethodName == name {
+				return method, file.Messages().ByName(protoreflect.Name(name + "Request")), file.Messages().ByName(protoreflect.Name(name + "Response"))
+			}
+		}
+	}
+
+	t.Fatalf("unknown query method %s of %s", name, module)
+
+	return grpc.MethodDesc{}, nil, nil
+}
+
+func TestQueryServices(t *testing.T) {
+	for _, service := range queryServices {
+		desc, err := service.serviceDesc()
+		require.NoError(t, err)
+		require.Equal(t, "heimdall."+service.module+".Query", desc.ServiceName)
+		require.Len(t, desc.Methods, len(service.methods))
+
+		file, err := service.file()
+		require.NoError(t, err)
+		require.Equal(t, len(service.methods), file.Services().Get(0).Methods().Len())
+
+		for i, method := range service.methods {
+			require.Equal(t, method.name, desc.Methods[i].MethodName)
+
+			srv := &testQueryServer{}
+
+			resp, err := desc.Methods[i].Handler(srv, context.Background(), func(interface{}) error { return nil }, nil)
+			require.NoError(t, err)
+			require.Equal(t, service.route, srv.route)
+			require.Equal(t, method.path, srv.path)
+
+			msg := resp.(*dynamicpb.Message)
+			require.Equal(t, int64(42), msg.Get(msg.Descriptor().Fields().ByName(queryHeightField)).Int())
+
+			if method.params == nil {
+				require.Nil(t, srv.params)
+			} else {
+				require.IsType(t, method.params(), srv.params)
+			}
+		}
+	}
+}
+
+func TestQueryRequest(t *testing.T) {
+	method, request, _ := testQueryMethod(t, "gov", "Vote")
+
+	// the request has the height and the json fields of the params, typed
+	in := dynamicpb.NewMessage(request)
+	require.NoError(t, protojson.Unmarshal([]byte(`{"height":"10","ProposalID":"18446744073709551615","Voter":3}`), in))
+
+	srv := &testQueryServer{}
+
+	_, err := method.Handler(srv, context.Background(), func(req interface{}) error {
+		proto.Merge(req.(*dynamicpb.Message), in)
+		return nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"height": "10", "ProposalID": "18446744073709551615", "Voter": "3"}, srv.fields)
+
+	// the integer fields do not take other values
+	require.Error(t, protojson.Unmarshal([]byte(`{"ProposalID":"one"}`), dynamicpb.NewMessage(request)))
+}
+
+func TestQueryData(t *testing.T) {
+	t.Parallel()
+
+	server := &HeimdallGRPCServer{cdc: codec.New()}
+
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		params interface{}
+		want   interface{}
+	}{
+		{
+			name:   "integer beyond double precision as string",
+			fields: map[string]interface{}{"ProposalID": "18446744073709551615"},
+			params: &govTypes.QueryProposalParams{},
+			want:   govTypes.NewQueryProposalParams(math.MaxUint64),
+		},
+		{
+			name:   "integer as number",
+			fields: map[string]interface{}{"validator_id": float64(3)},
+			params: &stakingTypes.QueryValidatorParams{},
+			want:   stakingTypes.NewQueryValidatorParams(3),
+		},
+		{
+			name:   "field names matched case insensitively",
+			fields: map[string]interface{}{"page": "2", "limit": float64(1e6)},
+			params: &slashingTypes.QuerySigningInfosParams{},
+			want:   slashingTypes.NewQuerySigningInfosParams(2, 1000000),
+		},
+		{
+			name:   "signer address as hex",
+			fields: map[string]interface{}{"signer_address": "0x0000000000000000000000000000000000000abc"},
+			params: &signerRequest{},
+			want:   stakingTypes.NewQuerySignerParams(hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000abc").Bytes()),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := server.queryData(tc.fields, tc.params)
+			require.NoError(t, err)
+
+			want, err := server.cdc.MarshalJSON(tc.want)
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(data))
+		})
+	}
+
+	// a string that is not an integer is rejected instead of being sent as zero
+	_, err := server.queryData(map[string]interface{}{"ProposalID": "one"}, &govTypes.QueryProposalParams{})
+	require.Error(t, err)
+
+	_, err = server.queryData(map[string]interface{}{"ProposalID": float64(1.5)}, &govTypes.QueryProposalParams{})
+	require.Error(t, err)
+}
+
+func TestQueryResponse(t *testing.T) {
+	_, _, response := testQueryMethod(t, "staking", "Validator")
+
+	// the result is typed, with the integers beyond the precision of a double exact
+	resp, err := queryResponse(response, []byte(`{"ID":18446744073709551615,"startEpoch":1,"power":10,"signer":"0x0000000000000000000000000000000000000abc","jailed":true,"unknown":1}`), 42, hmTypes.Validator{})
+	require.NoError(t, err)
+
+	bz, err := protojson.Marshal(resp)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"height":"42","result":{"ID":"18446744073709551615","startEpoch":"1","power":"10","signer":"0x0000000000000000000000000000000000000abc","jailed":true}}`, string(bz))
+
+	// a query without result
+	resp, err = queryResponse(response, nil, 42, hmTypes.Validator{})
+	require.NoError(t, err)
+
+	bz, err = protojson.Marshal(resp)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"height":"42"}`, string(bz))
+
+	// a result that does not match its type
+	_, err = queryResponse(response, []byte(`{"ID":"one"}`), 42, hmTypes.Validator{})
+	require.Error(t, err)
+
+	_, err = queryResponse(response, []byte(`{"ID":`), 42, hmTypes.Validator{})
+	require.Error(t, err)
+
+	// the results without proto type are google.protobuf.Value, with the numbers as strings
+	_, _, response = testQueryMethod(t, "auth", "Account")
+
+	resp, err = queryResponse(response, []byte(`{"type":"auth/Account","value":{"account_number":18446744073709551615}}`), 42, nil)
+	require.NoError(t, err)
+
+	bz, err = protojson.Marshal(resp)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"height":"42","result":{"type":"auth/Account","value":{"account_number":"18446744073709551615"}}}`, string(bz))
+
+	// the raw results are bytes
+	_, _, response = testQueryMethod(t, "topup", "DividendAccountRoot")
+
+	resp, err = queryResponse(response, []byte{1, 2}, 42, rawResult{})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2}, resp.Get(response.Fields().ByName(queryResultField)).Bytes())
+}
+
+func TestParseQueryHeight(t *testing.T) {
+	t.Parallel()
+
+	height, err := parseQueryHeight(float64(10))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+
+	height, err = parseQueryHeight("9007199254740993")
+	require.NoError(t, err)
+	require.Equal(t, int64(9007199254740993), height)
+
+	_, err = parseQueryHeight("latest")
+	require.Error(t, err)
+
+	_, err = parseQueryHeight(true)
+	require.Error(t, err)
+}
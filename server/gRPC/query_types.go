@@ -0,0 +1,438 @@
+package gRPC
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// valueTypeName is the proto message of the values without a proto type: interfaces, maps and nested lists
+const valueTypeName = ".google.protobuf.Value"
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	rawResultType     = reflect.TypeOf(rawResult(nil))
+)
+
+// rawResult is the result of the queriers returning raw bytes instead of json
+type rawResult []byte
+
+// messageBuilder describes go types as the proto messages of a file. The fields are named after the
+// json names of the struct fields, as encoded by the queriers. The types with their own json encoding
+// are strings, and the types without proto equivalent are google.protobuf.Value
+type messageBuilder struct {
+	file  *descriptorpb.FileDescriptorProto
+	names map[reflect.Type]string
+	used  map[string]bool
+}
+
+func newMessageBuilder(file *descriptorpb.FileDescriptorProto) *messageBuilder {
+	return &messageBuilder{
+		file:  file,
+		names: make(map[reflect.Type]string),
+		used:  make(map[string]bool),
+	}
+}
+
+// addMessage adds the message name with fields to the file
+func (b *messageBuilder) addMessage(name string, fields []*descriptorpb.FieldDescriptorProto) {
+	b.used[name] = true
+	b.file.MessageType = append(b.file.MessageType, &descriptorpb.DescriptorProto{
+		Name:  stringPtr(name),
+		Field: fields,
+	})
+}
+
+// typeName returns the full name of a message of the file
+func (b *messageBuilder) typeName(name string) string {
+	return fmt.Sprintf(".%s.%s", b.file.GetPackage(), name)
+}
+
+// field returns the proto field of a value of type t
+func (b *messageBuilder) field(name string, number int32, t reflect.Type) *descriptorpb.FieldDescriptorProto {
+	fieldType, typeName, repeated := b.fieldType(t)
+
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:     stringPtr(protoFieldName(name)),
+		JsonName: stringPtr(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     fieldType.Enum(),
+	}
+
+	if repeated {
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	}
+
+	if typeName != "" {
+		field.TypeName = stringPtr(typeName)
+	}
+
+	return field
+}
+
+// fieldType returns the proto type of a value of type t, and whether it is repeated
+func (b *messageBuilder) fieldType(t reflect.Type) (descriptorpb.FieldDescriptorProto_Type, string, bool) {
+	if t == nil {
+		return descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, valueTypeName, false
+	}
+
+	if t == rawResultType {
+		return descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", false
+	}
+
+	if hasOwnEncoding(t) {
+		return descriptorpb.FieldDescriptorProto_TYPE_STRING, "", false
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.fieldType(t.Elem())
+	case reflect.Bool:
+		return descriptorpb.FieldDescriptorProto_TYPE_BOOL, "", false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return descriptorpb.FieldDescriptorProto_TYPE_INT64, "", false
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return descriptorpb.FieldDescriptorProto_TYPE_UINT64, "", false
+	case reflect.Float32, reflect.Float64:
+		return descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "", false
+	case reflect.String:
+		return descriptorpb.FieldDescriptorProto_TYPE_STRING, "", false
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", false
+		}
+
+		elemType, elemTypeName, repeated := b.fieldType(t.Elem())
+		if repeated {
+			// lists of lists have no proto equivalent
+			return descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, valueTypeName, false
+		}
+
+		return elemType, elemTypeName, true
+	case reflect.Struct:
+		return descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, b.typeName(b.structMessage(t)), false
+	default:
+		return descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, valueTypeName, false
+	}
+}
+
+// structMessage returns the name of the message of the struct type t, adding it to the file once
+func (b *messageBuilder) structMessage(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if name == "" {
+		name = "Message"
+	}
+
+	for i := 2; b.used[name]; i++ {
+		name = fmt.Sprintf("%s%d", strings.TrimRight(name, "0123456789"), i)
+	}
+
+	// registered before the fields, for the recursive types
+	b.names[t] = name
+	b.used[name] = true
+
+	var fields []*descriptorpb.FieldDescriptorProto
+
+	for _, f := range jsonFields(t) {
+		fields = append(fields, b.field(f.name, int32(len(fields)+1), f.typ))
+	}
+
+	b.addMessage(name, fields)
+
+	return name
+}
+
+// jsonField is a field of a struct as encoded in json
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// jsonFields returns the fields of the struct type t as encoded in json, with the fields of the
+// embedded structs inlined
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+
+	seen := make(map[string]bool)
+
+	var walk func(t reflect.Type)
+	walk = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+
+			tag := f.Tag.Get("json")
+			name := strings.Split(tag, ",")[0]
+
+			if name == "-" || (f.PkgPath != "" && !f.Anonymous) {
+				continue
+			}
+
+			fieldType := f.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+
+			if f.Anonymous && name == "" && fieldType.Kind() == reflect.Struct && !hasOwnEncoding(fieldType) {
+				walk(fieldType)
+				continue
+			}
+
+			if f.PkgPath != "" {
+				continue
+			}
+
+			if name == "" {
+				name = f.Name
+			}
+
+			if seen[name] {
+				continue
+			}
+
+			seen[name] = true
+
+			fields = append(fields, jsonField{name, f.Type})
+		}
+	}
+
+	walk(t)
+
+	return fields
+}
+
+// hasOwnEncoding returns true if the values of type t are encoded by their json or text marshaler
+func hasOwnEncoding(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface {
+		return false
+	}
+
+	return t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)
+}
+
+// protoFieldName returns name with the characters not allowed in a proto field name replaced
+func protoFieldName(name string) string {
+	field := []byte(name)
+
+	for i, c := range field {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (i == 0 || c < '0' || c > '9') {
+			field[i] = '_'
+		}
+	}
+
+	return string(field)
+}
+
+// setMessage sets the fields of msg from value, a json object decoded with numbers. The conversions
+// are lenient: the numbers of strings fields are kept as strings, the integers may be strings, the
+// objects of string fields are compact json. The fields missing from msg are dropped
+func setMessage(msg protoreflect.Message, value interface{}) error {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s: expected an object, got %T", msg.Descriptor().FullName(), value)
+	}
+
+	descriptors := msg.Descriptor().Fields()
+
+	for i := 0; i < descriptors.Len(); i++ {
+		fd := descriptors.Get(i)
+
+		item, ok := fields[fd.JSONName()]
+		if !ok || item == nil {
+			continue
+		}
+
+		if err := setField(msg, fd, item); err != nil {
+			return fmt.Errorf("%s: %w", fd.FullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// setField sets the field fd of msg from value
+func setField(msg protoreflect.Message, fd protoreflect.FieldDescriptor, value interface{}) error {
+	if !fd.IsList() {
+		if fd.Message() != nil {
+			return setMessageValue(msg.Mutable(fd).Message(), value)
+		}
+
+		v, err := scalarValue(fd.Kind(), value)
+		if err != nil {
+			return err
+		}
+
+		msg.Set(fd, v)
+
+		return nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("expected a list, got %T", value)
+	}
+
+	list := msg.Mutable(fd).List()
+
+	for _, item := range items {
+		if fd.Message() != nil {
+			element := list.NewElement()
+			if item != nil {
+				if err := setMessageValue(element.Message(), item); err != nil {
+					return err
+				}
+			}
+
+			list.Append(element)
+
+			continue
+		}
+
+		v, err := scalarValue(fd.Kind(), item)
+		if err != nil {
+			return err
+		}
+
+		list.Append(v)
+	}
+
+	return nil
+}
+
+// setMessageValue sets msg, a message of the file or a google.protobuf.Value, from value
+func setMessageValue(msg protoreflect.Message, value interface{}) error {
+	if msg.Descriptor().FullName() != (&structpb.Value{}).ProtoReflect().Descriptor().FullName() {
+		return setMessage(msg, value)
+	}
+
+	v, err := structpb.NewValue(numbersToStrings(value))
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return proto.UnmarshalOptions{Merge: true}.Unmarshal(bz, msg.Interface())
+}
+
+// scalarValue converts value, decoded from json with numbers, to a proto value of kind
+func scalarValue(kind protoreflect.Kind, value interface{}) (protoreflect.Value, error) {
+	text, isText := value.(string)
+	if number, ok := value.(json.Number); ok {
+		text, isText = number.String(), true
+	}
+
+	switch kind {
+	case protoreflect.StringKind:
+		switch v := value.(type) {
+		case string, json.Number:
+			return protoreflect.ValueOfString(text), nil
+		case bool:
+			return protoreflect.ValueOfString(strconv.FormatBool(v)), nil
+		default:
+			bz, err := json.Marshal(v)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+
+			return protoreflect.ValueOfString(string(bz)), nil
+		}
+	case protoreflect.BoolKind:
+		if v, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(v), nil
+		}
+	case protoreflect.Int64Kind:
+		if isText {
+			v, err := strconv.ParseInt(text, 10, 64)
+			return protoreflect.ValueOfInt64(v), err
+		}
+	case protoreflect.Uint64Kind:
+		if isText {
+			v, err := strconv.ParseUint(text, 10, 64)
+			return protoreflect.ValueOfUint64(v), err
+		}
+	case protoreflect.DoubleKind:
+		if isText {
+			v, err := strconv.ParseFloat(text, 64)
+			return protoreflect.ValueOfFloat64(v), err
+		}
+	case protoreflect.BytesKind:
+		if isText {
+			v, err := base64.StdEncoding.DecodeString(text)
+			return protoreflect.ValueOfBytes(v), err
+		}
+	}
+
+	return protoreflect.Value{}, fmt.Errorf("invalid %s value %v", kind, value)
+}
+
+// messageFields returns the set fields of msg as a json object, with the integers as strings
+func messageFields(msg protoreflect.Message) map[string]interface{} {
+	fields := make(map[string]interface{})
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsList() {
+			fields[fd.JSONName()] = fieldValue(fd, v)
+			return true
+		}
+
+		list := v.List()
+		items := make([]interface{}, 0, list.Len())
+
+		for i := 0; i < list.Len(); i++ {
+			items = append(items, fieldValue(fd, list.Get(i)))
+		}
+
+		fields[fd.JSONName()] = items
+
+		return true
+	})
+
+	return fields
+}
+
+// fieldValue returns the json value of a value of the field fd
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.Message().FullName() != (&structpb.Value{}).ProtoReflect().Descriptor().FullName() {
+			return messageFields(v.Message())
+		}
+
+		value := new(structpb.Value)
+
+		bz, err := proto.Marshal(v.Message().Interface())
+		if err != nil || proto.Unmarshal(bz, value) != nil {
+			return nil
+		}
+
+		return value.AsInterface()
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,103 @@
+package gRPC
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	// registers the well known types used by the services not generated from proto files
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// extensionsFileName is the name of the proto file describing the hand written services of the heimdall package
+const extensionsFileName = "heimdall/heimdall_extensions.proto"
+
+// extensionsFileDescriptor describes the hand written services of the heimdall package, which
+// reuse the polyproto and well known messages
+func extensionsFileDescriptor() *descriptorpb.FileDescriptorProto {
+	method := func(name string, input string, output string) *descriptorpb.MethodDescriptorProto {
+		return &descriptorpb.MethodDescriptorProto{
+			Name:       stringPtr(name),
+			InputType:  stringPtr(input),
+			OutputType: stringPtr(output),
+		}
+	}
+
+	stream := func(name string, input string, output string) *descriptorpb.MethodDescriptorProto {
+		m := method(name, input, output)
+		m.ServerStreaming = proto.Bool(true)
+
+		return m
+	}
+
+	return &descriptorpb.FileDescriptorProto{
+		Name:    stringPtr(extensionsFileName),
+		Package: stringPtr("heimdall"),
+		Dependency: []string{
+			"heimdall/heimdall.proto",
+			"google/protobuf/struct.proto",
+			"google/protobuf/wrappers.proto",
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name:   stringPtr("MilestoneArchive"),
+				Method: []*descriptorpb.MethodDescriptorProto{method("FetchMilestoneByBorBlock", ".google.protobuf.UInt64Value", ".heimdall.FetchMilestoneResponse")},
+			},
+			{
+				Name:   stringPtr("CheckpointProof"),
+				Method: []*descriptorpb.MethodDescriptorProto{method("FetchCheckpointProof", ".google.protobuf.UInt64Value", ".google.protobuf.Struct")},
+			},
+			{
+				Name:   stringPtr("CheckpointIndex"),
+				Method: []*descriptorpb.MethodDescriptorProto{method("FetchCheckpointByBorBlock", ".google.protobuf.UInt64Value", ".heimdall.FetchCheckpointResponse")},
+			},
+			{
+				Name: stringPtr("Subscription"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					stream("SubscribeCheckpoints", ".google.protobuf.UInt64Value", ".heimdall.FetchCheckpointResponse"),
+					stream("SubscribeMilestones", ".google.protobuf.UInt64Value", ".heimdall.FetchMilestoneResponse"),
+					stream("SubscribeNoAckMilestones", ".google.protobuf.StringValue", ".heimdall.FetchLastNoAckMilestoneResponse"),
+					stream("SubscribeSpans", ".google.protobuf.UInt64Value", ".heimdall.SpanResponse"),
+				},
+			},
+		},
+		Syntax: stringPtr("proto3"),
+	}
+}
+
+// registerReflection registers the descriptors of the services not generated from proto files
+// and the grpc reflection service
+func registerReflection(grpcServer *grpc.Server) error {
+	descriptors := []*descriptorpb.FileDescriptorProto{extensionsFileDescriptor()}
+	for _, service := range queryServices {
+		descriptors = append(descriptors, service.fileDescriptor())
+	}
+
+	for _, descriptor := range descriptors {
+		if err := registerFileDescriptor(descriptor); err != nil {
+			return err
+		}
+	}
+
+	reflection.Register(grpcServer)
+
+	return nil
+}
+
+// registerFileDescriptor registers descriptor in the global proto registry, unless already registered
+func registerFileDescriptor(descriptor *descriptorpb.FileDescriptorProto) error {
+	if _, err := protoregistry.GlobalFiles.FindFileByPath(descriptor.GetName()); err == nil {
+		return nil
+	}
+
+	file, err := protodesc.NewFile(descriptor, protoregistry.GlobalFiles)
+	if err != nil {
+		return err
+	}
+
+	return protoregistry.GlobalFiles.RegisterFile(file)
+}
@@ -0,0 +1,483 @@
+package gRPC
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	proto "github.com/maticnetwork/polyproto/heimdall"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+const (
+	// blockSubscriber is the tendermint subscriber of the new blocks notified to the subscriptions
+	blockSubscriber = "grpc-subscriptions"
+
+	// blockEventsCapacity is the capacity of the new block events channel
+	blockEventsCapacity = 100
+)
+
+// errNotAvailable is returned by the subscriptions sending an item that is no longer available on
+// the node, such as a milestone pruned from the state. It is mapped to codes.OutOfRange
+var errNotAvailable = errors.New("not available")
+
+// blockSource notifies the subscriptions of the blocks committed by the node
+type blockSource interface {
+	// subscribe returns a channel receiving a value after new blocks, closed when the source stops
+	subscribe() (chan struct{}, error)
+	unsubscribe(chan struct{})
+	stop()
+}
+
+// SubscriptionServer pushes the new checkpoints, milestones, no-ack milestones and spans as
+// they are committed, checking for new ones after every block committed by the node.
+// The service is not part of polyproto, it streams the polyproto responses.
+//
+// The checkpoint, milestone and span subscriptions take the number of the first item to send,
+// so that a client can resume from the last item it received, or 0 to start from the latest one.
+// The items are sent in order, without gaps. A subscription resuming from an item that is no longer
+// available, such as a pruned milestone, fails with codes.OutOfRange.
+type SubscriptionServer interface {
+	// SubscribeCheckpoints streams the acked checkpoints from a checkpoint number
+	SubscribeCheckpoints(*wrapperspb.UInt64Value, grpc.ServerStream) error
+	// SubscribeMilestones streams the milestones from a milestone number
+	SubscribeMilestones(*wrapperspb.UInt64Value, grpc.ServerStream) error
+	// SubscribeNoAckMilestones streams the last no-ack milestone id whenever it changes from
+	// the given one, the current one is sent first if the given one is empty
+	SubscribeNoAckMilestones(*wrapperspb.StringValue, grpc.ServerStream) error
+	// SubscribeSpans streams the spans from a span id
+	SubscribeSpans(*wrapperspb.UInt64Value, grpc.ServerStream) error
+}
+
+// SubscriptionServiceDesc is the grpc service description of SubscriptionServer
+var SubscriptionServiceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.Subscription",
+	HandlerType: (*SubscriptionServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeCheckpoints",
+			Handler:       subscribeCheckpointsHandler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMilestones",
+			Handler:       subscribeMilestonesHandler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNoAckMilestones",
+			Handler:       subscribeNoAckMilestonesHandler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeSpans",
+			Handler:       subscribeSpansHandler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: extensionsFileName,
+}
+
+func subscribeCheckpointsHandler(srv interface{}, stream grpc.ServerStream) error {
+	in := new(wrapperspb.UInt64Value)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(SubscriptionServer).SubscribeCheckpoints(in, stream)
+}
+
+func subscribeMilestonesHandler(srv interface{}, stream grpc.ServerStream) error {
+	in := new(wrapperspb.UInt64Value)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(SubscriptionServer).SubscribeMilestones(in, stream)
+}
+
+func subscribeNoAckMilestonesHandler(srv interface{}, stream grpc.ServerStream) error {
+	in := new(wrapperspb.StringValue)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(SubscriptionServer).SubscribeNoAckMilestones(in, stream)
+}
+
+func subscribeSpansHandler(srv interface{}, stream grpc.ServerStream) error {
+	in := new(wrapperspb.UInt64Value)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(SubscriptionServer).SubscribeSpans(in, stream)
+}
+
+// SubscribeCheckpoints streams the acked checkpoints from a checkpoint number
+func (h *HeimdallGRPCServer) SubscribeCheckpoints(in *wrapperspb.UInt64Value, stream grpc.ServerStream) error {
+	latest := func(cliCtx cliContext.CLIContext) (uint64, error) {
+		var count uint64
+
+		result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryAckCount, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		err = json.Unmarshal(result.Result, &count)
+
+		return count, err
+	}
+
+	send := func(cliCtx cliContext.CLIContext, number uint64) error {
+		result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryCheckpoint, checkpointTypes.NewQueryCheckpointParams(number))
+		if err != nil {
+			return err
+		}
+
+		resp, err := toFetchCheckpointResponse(result)
+		if err != nil {
+			return err
+		}
+
+		return stream.SendMsg(resp)
+	}
+
+	return h.follow(stream, "checkpoints", in.GetValue(), 1, latest, send)
+}
+
+// SubscribeMilestones streams the milestones from a milestone number
+func (h *HeimdallGRPCServer) SubscribeMilestones(in *wrapperspb.UInt64Value, stream grpc.ServerStream) error {
+	latest := func(cliCtx cliContext.CLIContext) (uint64, error) {
+		var count uint64
+
+		result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryCount, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		err = json.Unmarshal(result.Result, &count)
+
+		return count, err
+	}
+
+	send := func(cliCtx cliContext.CLIContext, number uint64) error {
+		result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryMilestoneByNumber, checkpointTypes.NewQueryMilestoneParams(number))
+		if err != nil {
+			// milestones are pruned from the state, unless the node keeps a milestone archive. The
+			// milestone is missing if the node still answers the count
+			if count, countErr := latest(cliCtx); countErr == nil && number <= count {
+				return fmt.Errorf("milestone %d pruned from the state, %w", number, errNotAvailable)
+			}
+
+			return err
+		}
+
+		resp, err := toFetchMilestoneResponse(result)
+		if err != nil {
+			return err
+		}
+
+		return stream.SendMsg(resp)
+	}
+
+	return h.follow(stream, "milestones", in.GetValue(), 1, latest, send)
+}
+
+// SubscribeNoAckMilestones streams the last no-ack milestone id whenever it changes
+func (h *HeimdallGRPCServer) SubscribeNoAckMilestones(in *wrapperspb.StringValue, stream grpc.ServerStream) error {
+	blocks, err := h.blocks.subscribe()
+	if err != nil {
+		logger.Error("Error while subscribing to new blocks", "error", err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	defer h.blocks.unsubscribe(blocks)
+
+	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+	last := in.GetValue()
+
+	for {
+		result, err := queryWithHeight(cliCtx, checkpointTypes.QuerierRoute, checkpointTypes.QueryLatestNoAckMilestone, nil)
+		if err != nil {
+			logger.Error("Error while fetching last no ack milestone", "error", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		var milestoneID string
+
+		if len(result.Result) != 0 {
+			if err := json.Unmarshal(result.Result, &milestoneID); err != nil {
+				logger.Error("Error unmarshalling milestone", "error", err)
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		if milestoneID != "" && milestoneID != last {
+			resp := &proto.FetchLastNoAckMilestoneResponse{
+				Height: fmt.Sprint(result.Height),
+				Result: &proto.LastNoAckMilestone{Result: milestoneID},
+			}
+
+			if err := stream.SendMsg(resp); err != nil {
+				return err
+			}
+
+			last = milestoneID
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case _, ok := <-blocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "new block subscription closed")
+			}
+		}
+	}
+}
+
+// SubscribeSpans streams the spans from a span id
+func (h *HeimdallGRPCServer) SubscribeSpans(in *wrapperspb.UInt64Value, stream grpc.ServerStream) error {
+	latest := func(cliCtx cliContext.CLIContext) (uint64, error) {
+		var span hmTypes.Span
+
+		result, err := queryWithHeight(cliCtx, borTypes.QuerierRoute, borTypes.QueryLatestSpan, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		err = json.Unmarshal(result.Result, &span)
+
+		return span.ID, err
+	}
+
+	send := func(cliCtx cliContext.CLIContext, id uint64) error {
+		result, err := queryWithHeight(cliCtx, borTypes.QuerierRoute, borTypes.QuerySpan, borTypes.NewQuerySpanParams(id))
+		if err != nil {
+			return err
+		}
+
+		resp := &proto.SpanResponse{}
+		resp.Result = parseSpan(result.Result)
+		resp.Height = fmt.Sprint(result.Height)
+
+		if resp.Result == nil {
+			return fmt.Errorf("invalid span %d", id)
+		}
+
+		return stream.SendMsg(resp)
+	}
+
+	return h.follow(stream, "spans", in.GetValue(), 0, latest, send)
+}
+
+// follow sends the items of a subscription from number onwards, 0 meaning the latest one, then
+// checks for new items after every committed block until the client goes away.
+// first is the number of the first item, latest returns the number of the latest item and
+// send sends the item of a number
+func (h *HeimdallGRPCServer) follow(
+	stream grpc.ServerStream,
+	name string,
+	number uint64,
+	first uint64,
+	latest func(cliContext.CLIContext) (uint64, error),
+	send func(cliContext.CLIContext, uint64) error,
+) error {
+	// subscribe before the first lookup, so that no block is missed
+	blocks, err := h.blocks.subscribe()
+	if err != nil {
+		logger.Error("Error while subscribing to new blocks", "subscription", name, "error", err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	defer h.blocks.unsubscribe(blocks)
+
+	cliCtx := cliContext.NewCLIContext().WithCodec(h.cdc)
+	fromLatest := number == 0
+
+	for {
+		last, err := latest(cliCtx)
+		if err != nil {
+			logger.Error("Error while fetching latest", "subscription", name, "error", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if fromLatest {
+			number = last
+			fromLatest = false
+		}
+
+		if number < first {
+			number = first
+		}
+
+		for ; number <= last; number++ {
+			if err := send(cliCtx, number); err != nil {
+				if stream.Context().Err() != nil {
+					return nil
+				}
+
+				if errors.Is(err, errNotAvailable) {
+					return status.Error(codes.OutOfRange, err.Error())
+				}
+
+				logger.Error("Error while sending", "subscription", name, "number", number, "error", err)
+
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case _, ok := <-blocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "new block subscription closed")
+			}
+		}
+	}
+}
+
+// queryWithHeight queries path of a module querier route over ABCI
+func queryWithHeight(cliCtx cliContext.CLIContext, route string, path string, params interface{}) (rest.ResponseWithHeight, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if params != nil {
+		if data, err = cliCtx.Codec.MarshalJSON(params); err != nil {
+			return rest.ResponseWithHeight{}, err
+		}
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", route, path), data)
+	if err != nil {
+		return rest.ResponseWithHeight{}, err
+	}
+
+	return rest.ResponseWithHeight{Height: height, Result: res}, nil
+}
+
+// blockNotifier notifies the subscriptions of the blocks committed by the node. It shares a
+// single tendermint NewBlock subscription, started with the first subscription
+type blockNotifier struct {
+	nodeURI string
+
+	mu        sync.Mutex
+	client    *httpClient.HTTP
+	listeners map[chan struct{}]struct{}
+}
+
+func newBlockNotifier(nodeURI string) *blockNotifier {
+	return &blockNotifier{
+		nodeURI:   nodeURI,
+		listeners: make(map[chan struct{}]struct{}),
+	}
+}
+
+// subscribe returns a channel receiving a value after new blocks, closed when the notifier
+// stops. Blocks committed while the previous value is not received are coalesced
+func (n *blockNotifier) subscribe() (chan struct{}, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.client == nil {
+		if err := n.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	ch := make(chan struct{}, 1)
+	n.listeners[ch] = struct{}{}
+
+	return ch, nil
+}
+
+func (n *blockNotifier) unsubscribe(ch chan struct{}) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.listeners, ch)
+}
+
+// start subscribes to the new blocks of the node, to be called with the lock held
+func (n *blockNotifier) start() error {
+	client := httpClient.NewHTTP(n.nodeURI, "/websocket")
+	if err := client.Start(); err != nil {
+		return err
+	}
+
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+
+	events, err := client.Subscribe(context.Background(), blockSubscriber, query, blockEventsCapacity)
+	if err != nil {
+		if err := client.Stop(); err != nil {
+			logger.Error("Error while stopping tendermint client", "error", err)
+		}
+
+		return err
+	}
+
+	n.client = client
+
+	go n.run(events)
+
+	return nil
+}
+
+// run notifies the listeners of the new block events, the events channel is never closed
+// by the tendermint client
+func (n *blockNotifier) run(events <-chan ctypes.ResultEvent) {
+	for range events {
+		n.notify()
+	}
+}
+
+func (n *blockNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for ch := range n.listeners {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// stop stops the tendermint subscription, closing the listeners
+func (n *blockNotifier) stop() {
+	n.mu.Lock()
+	client := n.client
+
+	for ch := range n.listeners {
+		close(ch)
+	}
+
+	n.listeners = make(map[chan struct{}]struct{})
+	n.client = nil
+	n.mu.Unlock()
+
+	if client != nil && client.IsRunning() {
+		if err := client.Stop(); err != nil {
+			logger.Error("Error while stopping tendermint client", "error", err)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package gRPC
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/stretchr/testify/require"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	logger = tmLog.NewNopLogger()
+}
+
+// testBlockSource notifies the blocks sent on its channel
+type testBlockSource struct {
+	blocks chan struct{}
+	err    error
+}
+
+func newTestBlockSource() *testBlockSource {
+	return &testBlockSource{blocks: make(chan struct{}, 1)}
+}
+
+func (s *testBlockSource) subscribe() (chan struct{}, error) { return s.blocks, s.err }
+func (s *testBlockSource) unsubscribe(chan struct{})         {}
+func (s *testBlockSource) stop()                             { close(s.blocks) }
+
+// testServerStream is a server stream with a context, the items are sent by the send funcs of the tests
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+// testSubscription serves the items up to latest, recording the sent ones
+type testSubscription struct {
+	latest  uint64
+	sent    []uint64
+	onSent  func(number uint64)
+	sendErr map[uint64]error
+}
+
+func (s *testSubscription) latestFn(cliContext.CLIContext) (uint64, error) {
+	return s.latest, nil
+}
+
+func (s *testSubscription) sendFn(_ cliContext.CLIContext, number uint64) error {
+	if err := s.sendErr[number]; err != nil {
+		return err
+	}
+
+	s.sent = append(s.sent, number)
+
+	if s.onSent != nil {
+		s.onSent(number)
+	}
+
+	return nil
+}
+
+func TestFollowResume(t *testing.T) {
+	t.Parallel()
+
+	blocks := newTestBlockSource()
+	server := &HeimdallGRPCServer{blocks: blocks}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the subscription resumes from 3, then sends the items committed with the next block
+	subscription := &testSubscription{latest: 5}
+	subscription.onSent = func(number uint64) {
+		switch number {
+		case 5:
+			subscription.latest = 7
+			blocks.blocks <- struct{}{}
+		case 7:
+			cancel()
+		}
+	}
+
+	err := server.follow(&testServerStream{ctx: ctx}, "test", 3, 1, subscription.latestFn, subscription.sendFn)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5, 6, 7}, subscription.sent)
+}
+
+func TestFollowFromLatest(t *testing.T) {
+	t.Parallel()
+
+	server := &HeimdallGRPCServer{blocks: newTestBlockSource()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscription := &testSubscription{latest: 5, onSent: func(uint64) { cancel() }}
+
+	require.NoError(t, server.follow(&testServerStream{ctx: ctx}, "test", 0, 1, subscription.latestFn, subscription.sendFn))
+	require.Equal(t, []uint64{5}, subscription.sent)
+
+	// an empty chain starts from the first item
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	subscription = &testSubscription{latest: 0, onSent: func(uint64) { cancel() }}
+
+	require.NoError(t, server.follow(&testServerStream{ctx: ctx}, "test", 0, 0, subscription.latestFn, subscription.sendFn))
+	require.Equal(t, []uint64{0}, subscription.sent)
+}
+
+func TestFollowErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		blocks  *testBlockSource
+		latest  func(cliContext.CLIContext) (uint64, error)
+		sendErr error
+		code    codes.Code
+	}{
+		{
+			name:    "pruned item",
+			sendErr: fmt.Errorf("milestone 3 pruned from the state, %w", errNotAvailable),
+			code:    codes.OutOfRange,
+		},
+		{
+			name:    "send failure",
+			sendErr: errors.New("connection refused"),
+			code:    codes.Internal,
+		},
+		{
+			name:   "latest failure",
+			latest: func(cliContext.CLIContext) (uint64, error) { return 0, errors.New("connection refused") },
+			code:   codes.Internal,
+		},
+		{
+			name:   "block subscription failure",
+			blocks: &testBlockSource{err: errors.New("connection refused")},
+			code:   codes.Unavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			blocks := tc.blocks
+			if blocks == nil {
+				blocks = newTestBlockSource()
+			}
+
+			subscription := &testSubscription{latest: 5, sendErr: map[uint64]error{3: tc.sendErr}}
+
+			latest := tc.latest
+			if latest == nil {
+				latest = subscription.latestFn
+			}
+
+			server := &HeimdallGRPCServer{blocks: blocks}
+			err := server.follow(&testServerStream{ctx: context.Background()}, "test", 3, 1, latest, subscription.sendFn)
+			require.Equal(t, tc.code, status.Code(err), err)
+			require.Empty(t, subscription.sent)
+		})
+	}
+}
+
+func TestFollowBlocksStopped(t *testing.T) {
+	t.Parallel()
+
+	blocks := newTestBlockSource()
+	server := &HeimdallGRPCServer{blocks: blocks}
+
+	subscription := &testSubscription{latest: 2, onSent: func(number uint64) {
+		if number == 2 {
+			blocks.stop()
+		}
+	}}
+
+	err := server.follow(&testServerStream{ctx: context.Background()}, "test", 1, 1, subscription.latestFn, subscription.sendFn)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, []uint64{1, 2}, subscription.sent)
+}
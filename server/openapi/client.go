@@ -0,0 +1,267 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// GenerateClient returns the go source of the typed methods of the REST client in package
+// packageName, one per documented GET operation of doc. The methods are defined on a Client type
+// of the package, which has to implement:
+//
+//	get(path string, query url.Values) (rest.ResponseWithHeight, error)
+//	decode(bz []byte, v interface{}) error
+//	decodeAmino(bz []byte, v interface{}) error
+func GenerateClient(doc *Document, packageName string) ([]byte, error) {
+	g := &clientGenerator{imports: make(map[string]string), aliases: make(map[string]string)}
+
+	var methods bytes.Buffer
+
+	for _, op := range doc.Operations() {
+		if !op.Operation.Documented || op.Method != "GET" {
+			continue
+		}
+
+		if err := g.method(&methods, op); err != nil {
+			return nil, err
+		}
+	}
+
+	var src bytes.Buffer
+
+	fmt.Fprintf(&src, "// Code generated by server/openapi/gen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", packageName)
+
+	var std []string
+	if g.usesURL {
+		std = append(std, "net/url")
+	}
+
+	if g.usesFmt {
+		std = append(std, "fmt")
+	}
+
+	sort.Strings(std)
+
+	for _, path := range std {
+		fmt.Fprintf(&src, "\t%q\n", path)
+	}
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	if len(paths) > 0 {
+		src.WriteString("\n")
+	}
+
+	for _, path := range paths {
+		fmt.Fprintf(&src, "\t%s %q\n", g.imports[path], path)
+	}
+
+	src.WriteString(")\n")
+	src.Write(methods.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+type clientGenerator struct {
+	imports map[string]string // package path to alias
+	aliases map[string]string // alias to package path
+	usesFmt bool
+	usesURL bool
+}
+
+// method writes the client method of an operation
+func (g *clientGenerator) method(w *bytes.Buffer, op PathOperation) error {
+	operation := op.Operation
+
+	response, ok := operation.Responses["200"].Content["application/json"]
+	if !ok || response.Schema.Properties["result"] == nil || response.Schema.Properties["result"].GoType == "" {
+		return fmt.Errorf("operation %s has no result type", operation.OperationID)
+	}
+
+	resultType, err := g.goType(response.Schema.Properties["result"].GoType)
+	if err != nil {
+		return err
+	}
+
+	var (
+		args  []string
+		query []string
+	)
+
+	paramTypes := make(map[string]string)
+
+	for _, param := range operation.Parameters {
+		if param.Name == "height" && param.In == InQuery && param.Schema.GoType == "" {
+			// the query height of every GET route, not part of the typed methods
+			continue
+		}
+
+		paramType, err := g.goType(param.Schema.GoType)
+		if err != nil {
+			return err
+		}
+
+		name := goName(param.Name)
+		paramTypes[param.Name] = paramType
+		args = append(args, name+" "+paramType)
+
+		if param.In == InQuery {
+			query = append(query, fmt.Sprintf("query.Set(%q, %s)", param.Name, g.toString(name, paramType)))
+		}
+	}
+
+	// path expression, with the path params
+	var pathExpr []string
+
+	last := 0
+
+	for _, match := range templateVariable.FindAllStringSubmatchIndex(op.Path, -1) {
+		if match[0] > last {
+			pathExpr = append(pathExpr, fmt.Sprintf("%q", op.Path[last:match[0]]))
+		}
+
+		name := op.Path[match[2]:match[3]]
+
+		paramType, ok := paramTypes[name]
+		if !ok {
+			paramType = "string"
+		}
+
+		pathExpr = append(pathExpr, g.toString(goName(name), paramType))
+		last = match[1]
+	}
+
+	if last < len(op.Path) || len(pathExpr) == 0 {
+		pathExpr = append(pathExpr, fmt.Sprintf("%q", op.Path[last:]))
+	}
+
+	decode := "decode"
+	if operation.Amino {
+		decode = "decodeAmino"
+	}
+
+	queryArg := "nil"
+
+	fmt.Fprintf(w, "\n// %s %s\n//\n// %s %s\n", operation.OperationID, operation.Summary, op.Method, op.Path)
+	fmt.Fprintf(w, "func (c *Client) %s(%s) (result %s, height int64, err error) {\n", operation.OperationID, strings.Join(args, ", "), resultType)
+
+	if len(query) > 0 {
+		queryArg = "query"
+		g.usesURL = true
+
+		w.WriteString("query := url.Values{}\n")
+
+		for _, line := range query {
+			w.WriteString(line + "\n")
+		}
+
+		w.WriteString("\n")
+	}
+
+	fmt.Fprintf(w, "res, err := c.get(%s, %s)\n", strings.Join(pathExpr, " + "), queryArg)
+	w.WriteString("if err != nil {\nreturn result, 0, err\n}\n\n")
+	fmt.Fprintf(w, "err = c.%s(res.Result, &result)\n\nreturn result, res.Height, err\n}\n", decode)
+
+	return nil
+}
+
+// toString returns the expression formatting a param. The path params are not escaped, the
+// endpoint of the client escapes its path
+func (g *clientGenerator) toString(name string, goType string) string {
+	if goType == "string" {
+		return name
+	}
+
+	g.usesFmt = true
+
+	return "fmt.Sprint(" + name + ")"
+}
+
+// goType returns the go expression of a type name qualified by its package path, importing its packages
+func (g *clientGenerator) goType(name string) (string, error) {
+	switch {
+	case name == "":
+		return "", fmt.Errorf("missing go type")
+	case strings.HasPrefix(name, "*"):
+		elem, err := g.goType(name[1:])
+		return "*" + elem, err
+	case strings.HasPrefix(name, "[]"):
+		elem, err := g.goType(name[2:])
+		return "[]" + elem, err
+	case strings.HasPrefix(name, "map["):
+		end := strings.Index(name, "]")
+
+		key, err := g.goType(name[4:end])
+		if err != nil {
+			return "", err
+		}
+
+		elem, err := g.goType(name[end+1:])
+
+		return "map[" + key + "]" + elem, err
+	case name == "interface{}":
+		return name, nil
+	}
+
+	dot := strings.LastIndex(name, ".")
+	if dot < 0 {
+		// builtin type
+		return name, nil
+	}
+
+	return g.importAlias(name[:dot]) + "." + name[dot+1:], nil
+}
+
+// importAlias imports a package, returning its alias
+func (g *clientGenerator) importAlias(path string) string {
+	if alias, ok := g.imports[path]; ok {
+		return alias
+	}
+
+	base := packageAlias(path)
+	alias := base
+
+	for i := 2; ; i++ {
+		if _, taken := g.aliases[alias]; !taken && alias != "url" && alias != "fmt" {
+			break
+		}
+
+		alias = fmt.Sprintf("%s%d", base, i)
+	}
+
+	g.imports[path] = alias
+	g.aliases[alias] = path
+
+	return alias
+}
+
+// goName returns the go name of a param, such as spanID for span_id
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' })
+
+	for i, word := range words {
+		if i > 0 {
+			if word == "id" {
+				word = "ID"
+			} else {
+				word = strings.ToUpper(word[:1]) + word[1:]
+			}
+		}
+
+		if strings.HasSuffix(word, "Id") {
+			word = strings.TrimSuffix(word, "Id") + "ID"
+		}
+
+		words[i] = word
+	}
+
+	return strings.Join(words, "")
+}
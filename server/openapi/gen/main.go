@@ -0,0 +1,62 @@
+// Command gen writes the OpenAPI document of the REST server, and the typed REST client generated from it:
+//
+//	go run ./server/openapi/gen -spec openapi.json -client client/restclient/client.gen.go
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/server"
+	"github.com/maticnetwork/heimdall/server/openapi"
+)
+
+func main() {
+	specFile := flag.String("spec", "", "file to write the OpenAPI document to")
+	clientFile := flag.String("client", "", "file to write the generated client to")
+	packageName := flag.String("package", "restclient", "package of the generated client")
+	flag.Parse()
+
+	// the routes registered by the REST server
+	cliCtx := context.NewCLIContext().WithCodec(app.MakeCodec())
+	router := mux.NewRouter()
+	server.RegisterRoutes(cliCtx, router)
+
+	doc := openapi.Generate(router, openapi.Routes)
+
+	// the described routes must still be registered, with the same path and method
+	for _, route := range openapi.Routes {
+		if operation, ok := doc.Paths[route.Path][strings.ToLower(route.Method)]; !ok || !operation.Documented {
+			log.Fatalln("Described route is not registered", "method", route.Method, "path", route.Path)
+		}
+	}
+
+	if *specFile != "" {
+		bz, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			log.Fatalln("Unable to marshal the OpenAPI document", "error", err)
+		}
+
+		if err := os.WriteFile(*specFile, append(bz, '\n'), 0600); err != nil {
+			log.Fatalln("Unable to write the OpenAPI document", "error", err)
+		}
+	}
+
+	if *clientFile != "" {
+		src, err := openapi.GenerateClient(doc, *packageName)
+		if err != nil {
+			log.Fatalln("Unable to generate the client", "error", err)
+		}
+
+		if err := os.WriteFile(*clientFile, src, 0600); err != nil {
+			log.Fatalln("Unable to write the client", "error", err)
+		}
+	}
+}
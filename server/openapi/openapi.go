@@ -0,0 +1,315 @@
+// Package openapi generates the OpenAPI 3 document of the REST server from its registered routes
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+// Path is the path of the OpenAPI document on the REST server
+const Path = "/openapi.json"
+
+// Version is the OpenAPI version of the generated documents
+const Version = "3.0.3"
+
+// parameter locations
+const (
+	InPath  = "path"
+	InQuery = "query"
+)
+
+// Document is an OpenAPI 3 document, limited to the objects used by the REST server
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info is the metadata of the API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem maps the lower case http methods of a path to their operation
+type PathItem map[string]*Operation
+
+// Components holds the schemas of the named types
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Operation is a route of the REST server
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+
+	// Documented is set when the result of the operation is described in the route table
+	Documented bool `json:"x-documented"`
+	// Amino is set when the result is encoded with the amino codec
+	Amino bool `json:"x-amino,omitempty"`
+}
+
+// Parameter is a path or query parameter of an operation
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the json body of an operation
+type RequestBody struct {
+	Content map[string]MediaType `json:"content"`
+}
+
+// Response is a response of an operation
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the schema of a content type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Generate returns the OpenAPI document of the routes registered on router. Every route gets an
+// operation, the routes described in routes also get the schema of their parameters and result
+func Generate(router *mux.Router, routes []Route) *Document {
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       Info{Title: "Heimdall REST API", Version: "1"},
+		Paths:      make(map[string]PathItem),
+		Components: Components{Schemas: make(map[string]*Schema)},
+	}
+
+	described := make(map[string]Route, len(routes))
+	for _, route := range routes {
+		described[operationKey(route.Method, route.Path)] = route
+	}
+
+	schemas := newSchemaGenerator(doc.Components.Schemas)
+
+	_ = router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			// the route matches on something else than the path
+			return nil
+		}
+
+		// prefix routes, such as the swagger ui, serve files rather than an API
+		if pathRegexp, err := route.GetPathRegexp(); err != nil || !strings.HasSuffix(pathRegexp, "$") {
+			return nil
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			methods = []string{http.MethodGet}
+		}
+
+		path := cleanTemplate(template)
+
+		for _, method := range methods {
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = make(PathItem)
+				doc.Paths[path] = item
+			}
+
+			item[strings.ToLower(method)] = newOperation(method, path, described, schemas)
+		}
+
+		return nil
+	})
+
+	return doc
+}
+
+// Handler serves doc as json
+func Handler(doc *Document) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, err := json.Marshal(doc)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
+
+// Operations returns the operations of doc sorted by path and method, with their path and method
+func (doc *Document) Operations() []PathOperation {
+	var operations []PathOperation
+
+	for path, item := range doc.Paths {
+		for method, operation := range item {
+			operations = append(operations, PathOperation{Path: path, Method: strings.ToUpper(method), Operation: operation})
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Path != operations[j].Path {
+			return operations[i].Path < operations[j].Path
+		}
+
+		return operations[i].Method < operations[j].Method
+	})
+
+	return operations
+}
+
+// PathOperation is an operation with its path and method
+type PathOperation struct {
+	Path      string
+	Method    string
+	Operation *Operation
+}
+
+// newOperation returns the operation of a route, described by the route table if listed in it
+func newOperation(method string, path string, described map[string]Route, schemas *schemaGenerator) *Operation {
+	route, documented := described[operationKey(method, path)]
+
+	operation := &Operation{
+		OperationID: route.OperationID,
+		Summary:     route.Summary,
+		Tags:        []string{tag(path)},
+		Documented:  documented,
+		Amino:       route.Amino,
+	}
+
+	if operation.OperationID == "" {
+		operation.OperationID = defaultOperationID(method, path)
+	}
+
+	params := make(map[string]Param, len(route.Params))
+	for _, param := range route.Params {
+		params[param.In+":"+param.Name] = param
+	}
+
+	// path params, strings unless described
+	for _, name := range pathParams(path) {
+		parameter := Parameter{Name: name, In: InPath, Required: true, Schema: schemas.generate(reflectTypeOf(""), false)}
+
+		if param, ok := params[InPath+":"+name]; ok {
+			parameter.Description = param.Description
+			parameter.Schema = schemas.generate(reflectTypeOf(param.Type), false)
+		}
+
+		operation.Parameters = append(operation.Parameters, parameter)
+	}
+
+	for _, param := range route.Params {
+		if param.In != InQuery {
+			continue
+		}
+
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:        param.Name,
+			In:          InQuery,
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      schemas.generate(reflectTypeOf(param.Type), false),
+		})
+	}
+
+	if method == http.MethodGet {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:        "height",
+			In:          InQuery,
+			Description: "height of the query, latest if missing",
+			Schema:      &Schema{Type: "integer", Format: "int64"},
+		})
+	} else {
+		// the tx routes take a json body with the base request and the msg fields
+		operation.RequestBody = &RequestBody{
+			Content: map[string]MediaType{"application/json": {Schema: &Schema{Type: "object"}}},
+		}
+	}
+
+	result := &Schema{}
+	if route.Result != nil {
+		result = schemas.generate(reflectTypeOf(route.Result), route.Amino)
+	}
+
+	operation.Responses = map[string]Response{
+		"200": {
+			Description: "the result with the height of the query",
+			Content: map[string]MediaType{"application/json": {Schema: &Schema{
+				Type:     "object",
+				Required: []string{"height", "result"},
+				Properties: map[string]*Schema{
+					"height": {Type: "string", Format: "int64"},
+					"result": result,
+				},
+			}}},
+		},
+		"default": {
+			Description: "an error",
+			Content: map[string]MediaType{"application/json": {Schema: &Schema{
+				Type:       "object",
+				Properties: map[string]*Schema{"error": {Type: "string"}},
+			}}},
+		},
+	}
+
+	return operation
+}
+
+func operationKey(method string, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// templateVariable matches the variables of the mux path templates, with their optional pattern
+var templateVariable = regexp.MustCompile(`\{([^{}:]+)(:[^{}]*(\{[^{}]*\}[^{}]*)*)?\}`)
+
+// cleanTemplate removes the patterns of the variables of a mux path template
+func cleanTemplate(template string) string {
+	return templateVariable.ReplaceAllString(template, "{$1}")
+}
+
+// pathParams returns the variables of a path
+func pathParams(path string) []string {
+	var names []string
+
+	for _, match := range templateVariable.FindAllStringSubmatch(path, -1) {
+		names = append(names, match[1])
+	}
+
+	return names
+}
+
+// tag returns the tag of a path, its first segment
+func tag(path string) string {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	return segments[0]
+}
+
+// defaultOperationID returns the operation id of an undescribed route from its method and path,
+// such as getCheckpointsNumber for GET /checkpoints/{number}
+func defaultOperationID(method string, path string) string {
+	id := strings.ToLower(method)
+
+	for _, word := range strings.FieldsFunc(path, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		id += strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return id
+}
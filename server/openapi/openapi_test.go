@@ -0,0 +1,216 @@
+package openapi
+
+import (
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+type testHash [32]byte
+
+func (h testHash) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x")
+}
+
+type testBase struct {
+	Number uint64 `json:"number"`
+}
+
+type testResult struct {
+	testBase
+
+	Hash     testHash          `json:"hash"`
+	Data     []byte            `json:"data"`
+	Parent   *testResult       `json:"parent,omitempty"`
+	Counts   map[string]int64  `json:"counts"`
+	Nonce    uint64            `json:"nonce,string"`
+	Extra    interface{}       `json:"extra"`
+	Ignored  string            `json:"-"`
+	Validity map[string]string `json:"validity,omitempty"`
+	internal bool
+}
+
+var testRoutes = []Route{
+	{
+		Method: "GET", Path: "/checkpoints/{number}", OperationID: "GetTest",
+		Summary: "returns a test result",
+		Params: []Param{
+			{Name: "number", In: InPath, Type: uint64(0), Required: true},
+			{Name: "chain_id", In: InQuery, Type: "", Required: true},
+		},
+		Result: testResult{},
+	},
+	{
+		Method: "GET", Path: "/checkpoints/list", OperationID: "ListTests",
+		Result: []*testResult{}, Amino: true,
+	},
+}
+
+func testRouter() *mux.Router {
+	handler := func(w http.ResponseWriter, r *http.Request) {}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/checkpoints/list", handler).Methods("GET")
+	router.HandleFunc("/checkpoints/{number:[0-9]+}", handler).Methods("GET")
+	router.HandleFunc("/staking/validators", handler).Methods("POST", "PUT")
+	router.HandleFunc("/status", handler)
+	router.PathPrefix("/swagger-ui/").HandlerFunc(handler)
+
+	return router
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	doc := Generate(testRouter(), testRoutes)
+
+	require.Equal(t, Version, doc.OpenAPI)
+	require.Len(t, doc.Paths, 4)
+	require.NotContains(t, doc.Paths, "/swagger-ui/")
+
+	// described route
+	operation := doc.Paths["/checkpoints/{number}"]["get"]
+	require.NotNil(t, operation)
+	require.True(t, operation.Documented)
+	require.Equal(t, "GetTest", operation.OperationID)
+	require.Equal(t, []string{"checkpoints"}, operation.Tags)
+	require.Len(t, operation.Parameters, 3)
+	require.Equal(t, Parameter{Name: "number", In: InPath, Required: true, Schema: &Schema{Type: "integer", Format: "uint64", GoType: "uint64"}}, operation.Parameters[0])
+	require.Equal(t, "chain_id", operation.Parameters[1].Name)
+	require.Equal(t, "height", operation.Parameters[2].Name)
+
+	result := operation.Responses["200"].Content["application/json"].Schema.Properties["result"]
+	require.Equal(t, "#/components/schemas/openapi.testResult", result.Ref)
+	require.Equal(t, "github.com/maticnetwork/heimdall/server/openapi.testResult", result.GoType)
+
+	// undescribed routes
+	for _, method := range []string{"post", "put"} {
+		operation = doc.Paths["/staking/validators"][method]
+		require.NotNil(t, operation)
+		require.False(t, operation.Documented)
+		require.NotNil(t, operation.RequestBody)
+	}
+
+	require.Equal(t, "postStakingValidators", doc.Paths["/staking/validators"]["post"].OperationID)
+	require.Equal(t, "getStatus", doc.Paths["/status"]["get"].OperationID)
+
+	// served as json
+	rec := httptest.NewRecorder()
+	Handler(doc)(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var served map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	require.Equal(t, Version, served["openapi"])
+}
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	doc := Generate(testRouter(), testRoutes)
+
+	schema := doc.Components.Schemas["openapi.testResult"]
+	require.NotNil(t, schema)
+	require.Equal(t, "object", schema.Type)
+	require.ElementsMatch(t, []string{"number", "hash", "data", "counts", "nonce", "extra"}, schema.Required)
+
+	require.Equal(t, &Schema{Type: "integer", Format: "uint64"}, schema.Properties["number"])
+	require.Equal(t, &Schema{Type: "string"}, schema.Properties["hash"])
+	require.Equal(t, &Schema{Type: "string", Format: "byte"}, schema.Properties["data"])
+	require.Equal(t, &Schema{Ref: "#/components/schemas/openapi.testResult"}, schema.Properties["parent"])
+	require.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "integer", Format: "int64"}}, schema.Properties["counts"])
+	require.Equal(t, &Schema{Type: "string"}, schema.Properties["nonce"])
+	require.Equal(t, &Schema{}, schema.Properties["extra"])
+	require.NotContains(t, schema.Properties, "Ignored")
+	require.NotContains(t, schema.Properties, "internal")
+
+	// the amino results encode the 64 bit integers as strings
+	amino := doc.Components.Schemas["openapi.testResult.amino"]
+	require.NotNil(t, amino)
+	require.Equal(t, &Schema{Type: "string", Format: "uint64"}, amino.Properties["number"])
+	require.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string", Format: "int64"}}, amino.Properties["counts"])
+
+	list := doc.Paths["/checkpoints/list"]["get"].Responses["200"].Content["application/json"].Schema.Properties["result"]
+	require.Equal(t, "array", list.Type)
+	require.Equal(t, "#/components/schemas/openapi.testResult.amino", list.Items.Ref)
+}
+
+func TestGenerateClient(t *testing.T) {
+	t.Parallel()
+
+	src, err := GenerateClient(Generate(testRouter(), testRoutes), "restclient")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "client.gen.go", src, parser.AllErrors)
+	require.NoError(t, err)
+
+	code := string(src)
+	require.True(t, strings.HasPrefix(code, "// Code generated by server/openapi/gen. DO NOT EDIT."))
+	require.Contains(t, code, `openapi "github.com/maticnetwork/heimdall/server/openapi"`)
+	require.Contains(t, code, "func (c *Client) GetTest(number uint64, chainID string) (result openapi.testResult, height int64, err error) {")
+	require.Contains(t, code, `query.Set("chain_id", chainID)`)
+	require.Contains(t, code, `c.get("/checkpoints/"+fmt.Sprint(number), query)`)
+	require.Contains(t, code, "func (c *Client) ListTests() (result []*openapi.testResult, height int64, err error) {")
+	require.Contains(t, code, "err = c.decodeAmino(res.Result, &result)")
+
+	// only the described routes get a method
+	require.NotContains(t, code, "postStakingValidators")
+	require.NotContains(t, code, "getStatus")
+}
+
+func TestRoutes(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {}
+
+	router := mux.NewRouter()
+	for _, route := range Routes {
+		router.HandleFunc(route.Path, handler).Methods(route.Method)
+	}
+
+	doc := Generate(router, Routes)
+
+	ids := make(map[string]bool)
+
+	for _, route := range Routes {
+		operation := doc.Paths[route.Path][strings.ToLower(route.Method)]
+		require.NotNil(t, operation, route.Path)
+		require.True(t, operation.Documented, route.Path)
+		require.False(t, ids[route.OperationID], "duplicate operation id %s", route.OperationID)
+
+		ids[route.OperationID] = true
+	}
+
+	src, err := GenerateClient(doc, "restclient")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "client.gen.go", src, parser.AllErrors)
+	require.NoError(t, err)
+}
+
+func TestHelpers(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/gov/proposals/{proposal-id}/votes/{voter}", cleanTemplate("/gov/proposals/{proposal-id:[0-9]+}/votes/{voter}"))
+	require.Equal(t, "/a/{id}", cleanTemplate("/a/{id:[0-9]{1,3}}"))
+	require.Equal(t, []string{"proposal-id", "voter"}, pathParams("/gov/proposals/{proposal-id}/votes/{voter}"))
+
+	require.Equal(t, "getCheckpointsNumber", defaultOperationID("GET", "/checkpoints/{number}"))
+	require.Equal(t, "getBorNextSpanSeedId", defaultOperationID("GET", "/bor/next-span-seed/{id}"))
+
+	require.Equal(t, "hmTypes", packageAlias("github.com/maticnetwork/heimdall/types"))
+	require.Equal(t, "checkpointTypes", packageAlias("github.com/maticnetwork/heimdall/checkpoint/types"))
+	require.Equal(t, "common", packageAlias("github.com/ethereum/go-ethereum/common"))
+
+	require.Equal(t, "spanID", goName("span_id"))
+	require.Equal(t, "recordID", goName("recordId"))
+	require.Equal(t, "startBlock", goName("start_block"))
+	require.Equal(t, "id", goName("id"))
+}
@@ -0,0 +1,228 @@
+package openapi
+
+import (
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Route describes the parameters and the result of a REST route. The described routes get a typed
+// method in the generated client
+type Route struct {
+	Method      string
+	Path        string // mux path template, without the variable patterns
+	OperationID string // name of the client method
+	Summary     string
+	Params      []Param
+	Result      interface{} // zero value of the result type, or a nil pointer to an interface
+	Amino       bool        // the result is encoded by the amino codec, with 64 bit integers as strings
+}
+
+// Param is a path or query parameter of a route
+type Param struct {
+	Name        string
+	In          string      // InPath or InQuery
+	Type        interface{} // zero value of the parameter type
+	Required    bool        // always true for the path params
+	Description string
+}
+
+// txStatusParams are the params of the isoldtx routes
+var txStatusParams = []Param{
+	{Name: "txhash", In: InQuery, Type: "", Required: true, Description: "hash of the L1 tx of the event"},
+	{Name: "logindex", In: InQuery, Type: uint64(0), Required: true, Description: "index of the event log in the tx"},
+}
+
+// Routes describes the query routes used by the bridge and the integrators
+var Routes = []Route{
+	// auth
+	{
+		Method: "GET", Path: "/auth/accounts/{address}", OperationID: "GetAccount",
+		Summary: "returns the account of an address",
+		Result:  (*authTypes.Account)(nil), Amino: true,
+	},
+
+	// chainmanager
+	{
+		Method: "GET", Path: "/chainmanager/params", OperationID: "GetChainmanagerParams",
+		Summary: "returns the chainmanager params",
+		Result:  chainmanagerTypes.Params{},
+	},
+	{
+		Method: "GET", Path: "/chainmanager/pending-migrations", OperationID: "GetPendingChainParamsMigrations",
+		Summary: "returns the chain params migrations scheduled by governance",
+		Result:  []chainmanagerTypes.ChainParamsMigration{},
+	},
+
+	// checkpoint
+	{
+		Method: "GET", Path: "/checkpoints/params", OperationID: "GetCheckpointParams",
+		Summary: "returns the checkpoint params",
+		Result:  checkpointTypes.Params{},
+	},
+	{
+		Method: "GET", Path: "/checkpoints/buffer", OperationID: "GetBufferedCheckpoint",
+		Summary: "returns the checkpoint in buffer",
+		Result:  hmTypes.Checkpoint{},
+	},
+	{
+		Method: "GET", Path: "/checkpoints/latest", OperationID: "GetLatestCheckpoint",
+		Summary: "returns the latest acked checkpoint",
+		Result:  hmTypes.Checkpoint{},
+	},
+	{
+		Method: "GET", Path: "/checkpoints/count", OperationID: "GetCheckpointCount",
+		Summary: "returns the ack count",
+		Result:  checkpointTypes.ResultResponse{},
+	},
+	{
+		Method: "GET", Path: "/checkpoints/last-no-ack", OperationID: "GetLastNoAck",
+		Summary: "returns the time of the last no-ack",
+		Result:  checkpointTypes.ResultResponse{},
+	},
+	{
+		Method: "GET", Path: "/checkpoints/{number}", OperationID: "GetCheckpoint",
+		Summary: "returns an acked checkpoint by number",
+		Params:  []Param{{Name: "number", In: InPath, Type: uint64(0), Required: true}},
+		Result:  hmTypes.Checkpoint{},
+	},
+	{
+		Method: "GET", Path: "/milestone/params", OperationID: "GetMilestoneParams",
+		Summary: "returns the milestone params",
+		Result:  checkpointTypes.MilestoneParams{},
+	},
+	{
+		Method: "GET", Path: "/milestone/latest", OperationID: "GetLatestMilestone",
+		Summary: "returns the latest milestone",
+		Result:  hmTypes.Milestone{},
+	},
+	{
+		Method: "GET", Path: "/milestone/count", OperationID: "GetMilestoneCount",
+		Summary: "returns the milestone count",
+		Result:  checkpointTypes.Count{},
+	},
+	{
+		Method: "GET", Path: "/milestone/{number}", OperationID: "GetMilestone",
+		Summary: "returns a milestone by number",
+		Params:  []Param{{Name: "number", In: InPath, Type: uint64(0), Required: true}},
+		Result:  hmTypes.Milestone{},
+	},
+
+	// staking
+	{
+		Method: "GET", Path: "/staking/proposer/{times}", OperationID: "GetProposers",
+		Summary: "returns the next checkpoint proposers",
+		Params:  []Param{{Name: "times", In: InPath, Type: uint64(0), Required: true, Description: "number of proposers"}},
+		Result:  []hmTypes.Validator{},
+	},
+	{
+		Method: "GET", Path: "/staking/milestoneProposer/{times}", OperationID: "GetMilestoneProposers",
+		Summary: "returns the next milestone proposers",
+		Params:  []Param{{Name: "times", In: InPath, Type: uint64(0), Required: true, Description: "number of proposers"}},
+		Result:  []hmTypes.Validator{},
+	},
+	{
+		Method: "GET", Path: "/staking/current-proposer", OperationID: "GetCurrentProposer",
+		Summary: "returns the current checkpoint proposer",
+		Result:  hmTypes.Validator{},
+	},
+	{
+		Method: "GET", Path: "/staking/validator/{id}", OperationID: "GetValidator",
+		Summary: "returns a validator by id",
+		Params:  []Param{{Name: "id", In: InPath, Type: uint64(0), Required: true}},
+		Result:  hmTypes.Validator{},
+	},
+	{
+		Method: "GET", Path: "/staking/validator-set", OperationID: "GetValidatorSet",
+		Summary: "returns the current validator set",
+		Result:  hmTypes.ValidatorSet{},
+	},
+	{
+		Method: "GET", Path: "/staking/isoldtx", OperationID: "IsOldStakingTx",
+		Summary: "checks if a staking event was already processed",
+		Params:  txStatusParams,
+		Result:  false,
+	},
+
+	// topup
+	{
+		Method: "GET", Path: "/topup/isoldtx", OperationID: "IsOldTopupTx",
+		Summary: "checks if a topup event was already processed",
+		Params:  txStatusParams,
+		Result:  false,
+	},
+	{
+		Method: "GET", Path: "/topup/dividend-account-root", OperationID: "GetDividendAccountRoot",
+		Summary: "returns the root hash of the dividend accounts",
+		Result:  hmTypes.HeimdallHash{},
+	},
+
+	// clerk
+	{
+		Method: "GET", Path: "/clerk/isoldtx", OperationID: "IsOldClerkTx",
+		Summary: "checks if a state sync event was already processed",
+		Params:  txStatusParams,
+		Result:  false,
+	},
+	{
+		Method: "GET", Path: "/clerk/event-record/{recordId}", OperationID: "GetEventRecord",
+		Summary: "returns a state sync event record by id",
+		Params:  []Param{{Name: "recordId", In: InPath, Type: int64(0), Required: true}},
+		Result:  clerkTypes.EventRecord{},
+	},
+
+	// bor
+	{
+		Method: "GET", Path: "/bor/latest-span", OperationID: "GetLatestSpan",
+		Summary: "returns the latest span",
+		Result:  hmTypes.Span{},
+	},
+	{
+		Method: "GET", Path: "/bor/span/{id}", OperationID: "GetSpan",
+		Summary: "returns a span by id",
+		Params:  []Param{{Name: "id", In: InPath, Type: uint64(0), Required: true}},
+		Result:  hmTypes.Span{},
+	},
+	{
+		Method: "GET", Path: "/bor/prepare-next-span", OperationID: "PrepareNextSpan",
+		Summary: "returns the next span to propose",
+		Params: []Param{
+			{Name: "span_id", In: InQuery, Type: uint64(0), Required: true},
+			{Name: "start_block", In: InQuery, Type: uint64(0), Required: true},
+			{Name: "chain_id", In: InQuery, Type: "", Required: true, Description: "bor chain id"},
+		},
+		Result: hmTypes.Span{},
+	},
+	{
+		Method: "GET", Path: "/bor/next-span-seed/{id}", OperationID: "GetNextSpanSeed",
+		Summary: "returns the seed of a span and its author",
+		Params:  []Param{{Name: "id", In: InPath, Type: uint64(0), Required: true, Description: "span id"}},
+		Result:  borTypes.QuerySpanSeedResponse{},
+	},
+
+	// slashing
+	{
+		Method: "GET", Path: "/slashing/isoldtx", OperationID: "IsOldSlashingTx",
+		Summary: "checks if a slashing event was already processed",
+		Params:  txStatusParams,
+		Result:  false,
+	},
+	{
+		Method: "GET", Path: "/slashing/latest_slash_info_bytes", OperationID: "GetLatestSlashInfoBytes",
+		Summary: "returns the encoded slashing infos of the next tick",
+		Result:  hmTypes.HexBytes{},
+	},
+	{
+		Method: "GET", Path: "/slashing/tick_slash_infos", OperationID: "GetTickSlashInfos",
+		Summary: "returns the slashing infos of the current tick",
+		Result:  []*hmTypes.ValidatorSlashingInfo{},
+	},
+	{
+		Method: "GET", Path: "/slashing/tick-count", OperationID: "GetSlashingTickCount",
+		Summary: "returns the slashing tick count",
+		Result:  uint64(0),
+	},
+}
@@ -0,0 +1,261 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema is a json schema of the OpenAPI document
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+
+	// GoType is the go type the schema was generated from, qualified by its package path
+	GoType string `json:"x-go-type,omitempty"`
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	bigIntType        = reflect.TypeOf(big.Int{})
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// schemaGenerator generates the schemas of go types as encoded by encoding/json, or by the
+// amino codec which encodes the 64 bit integers as strings. The structs are added to the
+// components and referenced
+type schemaGenerator struct {
+	components map[string]*Schema
+}
+
+func newSchemaGenerator(components map[string]*Schema) *schemaGenerator {
+	return &schemaGenerator{components: components}
+}
+
+// reflectTypeOf returns the type of v, or the interface pointed by v if v is a pointer to an interface
+func reflectTypeOf(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Interface {
+		return t.Elem()
+	}
+
+	return t
+}
+
+// generate returns the schema of t, with its go type
+func (g *schemaGenerator) generate(t reflect.Type, amino bool) *Schema {
+	schema := *g.schema(t, amino)
+	schema.GoType = goTypeName(t)
+
+	return &schema
+}
+
+func (g *schemaGenerator) schema(t reflect.Type, amino bool) *Schema {
+	switch {
+	case t == rawMessageType:
+		return &Schema{}
+	case t == bigIntType:
+		return &Schema{Type: "integer"}
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface && implementsMarshaler(t):
+		// the custom encodings of the heimdall and ethereum types are hex strings
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		if amino {
+			return &Schema{Type: "string", Format: "int64"}
+		}
+
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint64:
+		if amino {
+			return &Schema{Type: "string", Format: "uint64"}
+		}
+
+		return &Schema{Type: "integer", Format: "uint64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && !implementsMarshaler(t.Elem()) {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: g.schema(t.Elem(), amino)}
+	case reflect.Array:
+		return &Schema{Type: "array", Items: g.schema(t.Elem(), amino)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem(), amino)}
+	case reflect.Ptr:
+		schema := *g.schema(t.Elem(), amino)
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+
+		return &schema
+	case reflect.Struct:
+		return g.structRef(t, amino)
+	default:
+		// interfaces, any value
+		return &Schema{}
+	}
+}
+
+// structRef adds the schema of a struct to the components, returning its reference
+func (g *schemaGenerator) structRef(t reflect.Type, amino bool) *Schema {
+	if t.Name() == "" {
+		return g.structSchema(t, amino)
+	}
+
+	name := packageAlias(t.PkgPath()) + "." + t.Name()
+	if amino {
+		name += ".amino"
+	}
+
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+
+	if _, ok := g.components[name]; !ok {
+		// registered first for the recursive types
+		schema := &Schema{}
+		g.components[name] = schema
+
+		*schema = *g.structSchema(t, amino)
+		schema.GoType = goTypeName(t)
+	}
+
+	return ref
+}
+
+// structSchema returns the schema of the json fields of a struct, following the encoding/json rules
+func (g *schemaGenerator) structSchema(t reflect.Type, amino bool) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		// the fields of the embedded structs are promoted
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct && !implementsMarshaler(fieldType) {
+			embedded := g.structSchema(fieldType, amino)
+			for k, v := range embedded.Properties {
+				schema.Properties[k] = v
+			}
+
+			schema.Required = append(schema.Required, embedded.Required...)
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if strings.Contains(options, "string") {
+			schema.Properties[name] = &Schema{Type: "string"}
+		} else {
+			schema.Properties[name] = g.schema(field.Type, amino)
+		}
+
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+func implementsMarshaler(t reflect.Type) bool {
+	for _, candidate := range []reflect.Type{t, reflect.PtrTo(t)} {
+		if candidate.Implements(jsonMarshalerType) || candidate.Implements(textMarshalerType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// goTypeName returns the name of a go type, with the named types qualified by their package path
+func goTypeName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+
+		return t.PkgPath() + "." + t.Name()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + goTypeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + goTypeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), goTypeName(t.Elem()))
+	case reflect.Map:
+		return "map[" + goTypeName(t.Key()) + "]" + goTypeName(t.Elem())
+	case reflect.Interface:
+		return "interface{}"
+	default:
+		return t.String()
+	}
+}
+
+// packageAlias returns the import alias of a package in the generated code and the schema names,
+// following the naming of the repo: hmTypes for the heimdall types, <module>Types for the module types
+func packageAlias(pkgPath string) string {
+	const heimdallTypes = "github.com/maticnetwork/heimdall/types"
+
+	if pkgPath == heimdallTypes {
+		return "hmTypes"
+	}
+
+	segments := strings.Split(pkgPath, "/")
+	alias := segments[len(segments)-1]
+
+	if alias == "types" && len(segments) > 1 {
+		alias = segments[len(segments)-2] + "Types"
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+
+		return -1
+	}, alias)
+}
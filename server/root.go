@@ -28,6 +28,9 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	tx "github.com/maticnetwork/heimdall/client/tx"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/server/cache"
+	"github.com/maticnetwork/heimdall/server/openapi"
+	"github.com/maticnetwork/heimdall/server/security"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
 
 	// unnamed import of statik for swagger UI support
@@ -48,6 +51,30 @@ func StartRestServer(mainCtx ctx.Context, cdc *codec.Codec, registerRoutesFn fun
 
 	registerRoutesFn(cliCtx, router)
 
+	// OpenAPI document of the routes registered above
+	router.HandleFunc(openapi.Path, openapi.Handler(openapi.Generate(router, openapi.Routes))).Methods("GET")
+
+	// TLS, authentication and rate limiting of the REST and gRPC servers
+	sec, err := newSecurity(helper.GetConfig())
+	if err != nil {
+		logger.Error("Invalid server security config", "error", err)
+		return err
+	}
+
+	// cache of the hot query routes, invalidated on new blocks
+	var handler http.Handler = router
+
+	if conf := helper.GetConfig(); conf.RESTCache {
+		responseCache := cache.New(cache.Config{
+			Routes:     security.SplitList(conf.RESTCacheRoutes),
+			MaxEntries: conf.RESTCacheMaxEntries,
+		})
+
+		go responseCache.Follow(mainCtx, cliCtx.NodeURI, logger)
+
+		handler = responseCache.Handler(router)
+	}
+
 	// server configuration
 	cfg := rpcserver.DefaultConfig()
 	cfg.MaxOpenConnections = viper.GetInt(client.FlagMaxOpenConnections)
@@ -92,12 +119,12 @@ func StartRestServer(mainCtx ctx.Context, cdc *codec.Codec, registerRoutesFn fun
 	g, gCtx := errgroup.WithContext(mainCtx)
 	// start serving
 	g.Go(func() error {
-		return startRPCServer(mainCtx, listener, router, logger, cfg)
+		return startRPCServer(mainCtx, sec.Listener(listener), sec.HTTPHandler(handler), logger, cfg)
 	})
 
 	// Setup gRPC server
 	gRPCLogger := tmLog.NewTMLogger(log.NewSyncWriter(logsWriter)).With("module", "gRPC-server")
-	if err := gRPC.SetupGRPCServer(mainCtx, cdc, viper.GetString(FlagGrpcAddr), gRPCLogger); err != nil {
+	if err := gRPC.SetupGRPCServer(mainCtx, cdc, viper.GetString(FlagGrpcAddr), gRPCLogger, sec.GRPCServerOptions()...); err != nil {
 		return err
 	}
 
@@ -118,6 +145,21 @@ func StartRestServer(mainCtx ctx.Context, cdc *codec.Codec, registerRoutesFn fun
 	return nil
 }
 
+// newSecurity returns the security of the servers from the heimdall config
+func newSecurity(conf helper.Configuration) (*security.Security, error) {
+	return security.New(security.Config{
+		TLSCertFile:        conf.ServerTLSCertFile,
+		TLSKeyFile:         conf.ServerTLSKeyFile,
+		TLSClientCAFile:    conf.ServerTLSClientCAFile,
+		APIKeys:            security.SplitList(conf.ServerAPIKeys),
+		JWTSecret:          conf.ServerJWTSecret,
+		RateLimit:          conf.ServerRateLimit,
+		RateLimitBurst:     conf.ServerRateLimitBurst,
+		ExpensiveRoutes:    security.SplitList(conf.ServerExpensiveRoutes),
+		ExpensiveRateLimit: conf.ServerExpensiveRateLimit,
+	})
+}
+
 // this is borrowed from maticnetwork rpcserver
 type maxBytesHandler struct {
 	h http.Handler
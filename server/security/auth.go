@@ -0,0 +1,141 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnauthenticated is returned when a request has no credentials
+	ErrUnauthenticated = errors.New("missing API key or bearer token")
+
+	// ErrInvalidCredentials is returned when the credentials of a request are not accepted
+	ErrInvalidCredentials = errors.New("invalid API key or bearer token")
+)
+
+// Authenticator authenticates the requests with an API key or a HS256 JWT bearer token
+type Authenticator struct {
+	apiKeys   [][]byte
+	jwtSecret []byte
+}
+
+// NewAuthenticator returns an authenticator accepting apiKeys and the tokens signed with
+// jwtSecret. Without keys nor secret, the authentication is disabled
+func NewAuthenticator(apiKeys []string, jwtSecret string) *Authenticator {
+	a := &Authenticator{}
+
+	for _, key := range apiKeys {
+		a.apiKeys = append(a.apiKeys, []byte(key))
+	}
+
+	if jwtSecret != "" {
+		a.jwtSecret = []byte(jwtSecret)
+	}
+
+	return a
+}
+
+// Enabled returns true if requests must be authenticated
+func (a *Authenticator) Enabled() bool {
+	return len(a.apiKeys) != 0 || len(a.jwtSecret) != 0
+}
+
+// Authenticate checks the API key or the bearer token of a request and returns the client
+// identity used for rate limiting, empty if the authentication is disabled
+func (a *Authenticator) Authenticate(apiKey string, bearerToken string) (string, error) {
+	if !a.Enabled() {
+		return "", nil
+	}
+
+	if apiKey != "" {
+		for _, key := range a.apiKeys {
+			if subtle.ConstantTimeCompare(key, []byte(apiKey)) == 1 {
+				hash := sha256.Sum256(key)
+				return fmt.Sprintf("key:%x", hash[:8]), nil
+			}
+		}
+
+		return "", ErrInvalidCredentials
+	}
+
+	if bearerToken != "" {
+		if len(a.jwtSecret) == 0 {
+			return "", ErrInvalidCredentials
+		}
+
+		subject, err := verifyJWT(bearerToken, a.jwtSecret, time.Now())
+		if err != nil {
+			return "", err
+		}
+
+		return "jwt:" + subject, nil
+	}
+
+	return "", ErrUnauthenticated
+}
+
+// jwtClaims are the registered claims checked on the bearer tokens
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt *int64 `json:"exp"`
+	NotBefore *int64 `json:"nbf"`
+}
+
+// verifyJWT verifies a HS256 token and its time claims, returning its subject
+func verifyJWT(token string, secret []byte, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrInvalidCredentials
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return "", ErrInvalidCredentials
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return "", ErrInvalidCredentials
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	if claims.ExpiresAt != nil && now.Unix() >= *claims.ExpiresAt {
+		return "", errors.New("bearer token expired")
+	}
+
+	if claims.NotBefore != nil && now.Unix() < *claims.NotBefore {
+		return "", errors.New("bearer token not valid yet")
+	}
+
+	return claims.Subject, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	bz, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bz, v)
+}
@@ -0,0 +1,88 @@
+package security
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// apiKeyMetadata is the metadata key of the API key of the gRPC requests
+	apiKeyMetadata = "x-api-key"
+
+	// authorizationMetadata is the metadata key of the bearer token of the gRPC requests
+	authorizationMetadata = "authorization"
+)
+
+// GRPCServerOptions returns the options enforcing the security of the gRPC server
+func (s *Security) GRPCServerOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.unaryInterceptor),
+		grpc.ChainStreamInterceptor(s.streamInterceptor),
+	}
+
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+
+	return opts
+}
+
+func (s *Security) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.checkGRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (s *Security) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.checkGRPC(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// checkGRPC authenticates and rate limits a gRPC call
+func (s *Security) checkGRPC(ctx context.Context, method string) error {
+	var apiKey, token string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(apiKeyMetadata); len(values) != 0 {
+			apiKey = values[0]
+		}
+
+		if values := md.Get(authorizationMetadata); len(values) != 0 {
+			token = bearerToken(values[0])
+		}
+	}
+
+	client, err := s.auth.Authenticate(apiKey, token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if client == "" {
+		client = "ip:unknown"
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			client = "ip:" + remoteIP(p.Addr.String())
+		}
+	}
+
+	if err := s.allow(client, method); err != nil {
+		if errors.Is(err, ErrRateLimited) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
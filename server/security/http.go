@@ -0,0 +1,69 @@
+package security
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+// APIKeyHeader is the header of the API key of the REST requests
+const APIKeyHeader = "X-API-Key"
+
+// Listener wraps the listener of the REST server with TLS, if enabled
+func (s *Security) Listener(listener net.Listener) net.Listener {
+	if s.tlsConfig == nil {
+		return listener
+	}
+
+	return tls.NewListener(listener, s.tlsConfig)
+}
+
+// HTTPHandler authenticates and rate limits the REST requests before handing them to next
+func (s *Security) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := s.auth.Authenticate(r.Header.Get(APIKeyHeader), bearerToken(r.Header.Get("Authorization")))
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			hmRest.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+
+			return
+		}
+
+		if client == "" {
+			client = "ip:" + remoteIP(r.RemoteAddr)
+		}
+
+		if err := s.allow(client, r.URL.Path); err != nil {
+			w.Header().Set("Retry-After", "1")
+			hmRest.WriteErrorResponse(w, http.StatusTooManyRequests, err.Error())
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns the token of an Authorization header, empty if it is not a bearer token
+func bearerToken(authorization string) string {
+	const prefix = "bearer "
+
+	if len(authorization) > len(prefix) && strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return strings.TrimSpace(authorization[len(prefix):])
+	}
+
+	return ""
+}
+
+// remoteIP returns the ip of a host:port remote address
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
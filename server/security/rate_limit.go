@@ -0,0 +1,78 @@
+package security
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// idleLimiterTimeout is the time after which the bucket of an inactive client is dropped
+const idleLimiterTimeout = 10 * time.Minute
+
+// RateLimiter is a token bucket rate limiter per client
+type RateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter returns a limiter of perSecond requests per client, with bursts of burst
+// requests, perSecond rounded up if not set. It returns nil, allowing every request, if perSecond is 0
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+
+	if burst < 1 {
+		burst = int(math.Ceil(perSecond))
+	}
+
+	return &RateLimiter{
+		limit:     rate.Limit(perSecond),
+		burst:     burst,
+		clients:   make(map[string]*clientLimiter),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow takes a token from the bucket of client, returning false if it is empty
+func (l *RateLimiter) Allow(client string) bool {
+	if l == nil {
+		return true
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > idleLimiterTimeout {
+		for c, cl := range l.clients {
+			if now.Sub(cl.lastSeen) > idleLimiterTimeout {
+				delete(l.clients, c)
+			}
+		}
+
+		l.lastSweep = now
+	}
+
+	cl, ok := l.clients[client]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[client] = cl
+	}
+
+	cl.lastSeen = now
+
+	return cl.limiter.AllowN(now, 1)
+}
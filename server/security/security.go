@@ -0,0 +1,135 @@
+// Package security implements the optional TLS, authentication and rate limiting
+// of the REST and gRPC servers
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config is the security configuration of the servers, from heimdall-config.toml
+type Config struct {
+	TLSCertFile     string // server certificate, enables TLS with TLSKeyFile
+	TLSKeyFile      string // server certificate key
+	TLSClientCAFile string // CA of the client certificates, enables mTLS
+
+	APIKeys   []string // accepted API keys, auth is required if any is set or JWTSecret is set
+	JWTSecret string   // HMAC secret of the accepted HS256 JWT bearer tokens
+
+	RateLimit          float64  // requests per second per client, 0 disables
+	RateLimitBurst     int      // token bucket size per client, RateLimit rounded up if not set
+	ExpensiveRoutes    []string // REST paths and gRPC methods limited by ExpensiveRateLimit too
+	ExpensiveRateLimit float64  // requests per second per client on the expensive routes, without bursts, 0 disables
+}
+
+// Security enforces the security configuration of the servers. The REST and gRPC servers share
+// it, so that a client has the same rate limit across both
+type Security struct {
+	tlsConfig *tls.Config
+	auth      *Authenticator
+	limiter   *RateLimiter
+	expensive *RateLimiter
+
+	expensiveRoutes map[string]struct{}
+}
+
+// New returns the security of the servers from config
+func New(config Config) (*Security, error) {
+	tlsConfig, err := NewTLSConfig(config.TLSCertFile, config.TLSKeyFile, config.TLSClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Security{
+		tlsConfig:       tlsConfig,
+		auth:            NewAuthenticator(config.APIKeys, config.JWTSecret),
+		limiter:         NewRateLimiter(config.RateLimit, config.RateLimitBurst),
+		expensive:       NewRateLimiter(config.ExpensiveRateLimit, 0),
+		expensiveRoutes: make(map[string]struct{}),
+	}
+
+	for _, route := range config.ExpensiveRoutes {
+		if route = strings.TrimSpace(route); route != "" {
+			s.expensiveRoutes[route] = struct{}{}
+		}
+	}
+
+	return s, nil
+}
+
+// TLSConfig returns the tls config of the servers, nil if TLS is disabled
+func (s *Security) TLSConfig() *tls.Config {
+	return s.tlsConfig
+}
+
+// allow checks the rate limits of a client on a route, returning the limit error if exceeded
+func (s *Security) allow(client string, route string) error {
+	if !s.limiter.Allow(client) {
+		return ErrRateLimited
+	}
+
+	if _, ok := s.expensiveRoutes[route]; ok && !s.expensive.Allow(client) {
+		return ErrRateLimited
+	}
+
+	return nil
+}
+
+// ErrRateLimited is returned when a client exceeds its rate limit
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// NewTLSConfig returns the tls config with the server certificate, requiring and verifying
+// client certificates when a client CA is given. It returns nil if no certificate is given
+func NewTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, errors.New("client CA requires a server certificate and key")
+		}
+
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load server certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in client CA %s", clientCAFile)
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// SplitList splits a comma separated config value, ignoring empty items
+func SplitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,380 @@
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func signJWT(t *testing.T, secret string, alg string, claims map[string]interface{}) string {
+	t.Helper()
+
+	encode := func(v interface{}) string {
+		bz, err := json.Marshal(v)
+		require.NoError(t, err)
+
+		return base64.RawURLEncoding.EncodeToString(bz)
+	}
+
+	unsigned := encode(map[string]string{"alg": alg, "typ": "JWT"}) + "." + encode(claims)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	// disabled without keys nor secret
+	client, err := NewAuthenticator(nil, "").Authenticate("", "")
+	require.NoError(t, err)
+	require.Empty(t, client)
+
+	auth := NewAuthenticator([]string{"key-1", "key-2"}, "secret")
+
+	client, err = auth.Authenticate("key-2", "")
+	require.NoError(t, err)
+	require.Contains(t, client, "key:")
+
+	other, err := auth.Authenticate("key-1", "")
+	require.NoError(t, err)
+	require.NotEqual(t, client, other)
+
+	_, err = auth.Authenticate("key-3", "")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = auth.Authenticate("", "")
+	require.ErrorIs(t, err, ErrUnauthenticated)
+
+	now := time.Now().Unix()
+
+	client, err = auth.Authenticate("", signJWT(t, "secret", "HS256", map[string]interface{}{"sub": "partner", "exp": now + 60}))
+	require.NoError(t, err)
+	require.Equal(t, "jwt:partner", client)
+
+	// expired, not valid yet, wrong secret and wrong algorithm
+	_, err = auth.Authenticate("", signJWT(t, "secret", "HS256", map[string]interface{}{"sub": "partner", "exp": now - 1}))
+	require.Error(t, err)
+
+	_, err = auth.Authenticate("", signJWT(t, "secret", "HS256", map[string]interface{}{"sub": "partner", "nbf": now + 60}))
+	require.Error(t, err)
+
+	_, err = auth.Authenticate("", signJWT(t, "other", "HS256", map[string]interface{}{"sub": "partner"}))
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = auth.Authenticate("", signJWT(t, "secret", "none", map[string]interface{}{"sub": "partner"}))
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// tokens are rejected without a secret
+	_, err = NewAuthenticator([]string{"key-1"}, "").Authenticate("", signJWT(t, "", "HS256", map[string]interface{}{"sub": "partner"}))
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	var disabled *RateLimiter
+	require.Nil(t, NewRateLimiter(0, 10))
+	require.True(t, disabled.Allow("client"))
+
+	limiter := NewRateLimiter(0.001, 2)
+	require.True(t, limiter.Allow("client-1"))
+	require.True(t, limiter.Allow("client-1"))
+	require.False(t, limiter.Allow("client-1"))
+
+	// separate buckets per client
+	require.True(t, limiter.Allow("client-2"))
+}
+
+func TestHTTPHandler(t *testing.T) {
+	t.Parallel()
+
+	sec, err := New(Config{
+		APIKeys:            []string{"key"},
+		RateLimit:          1000,
+		RateLimitBurst:     1000,
+		ExpensiveRoutes:    []string{"/checkpoints/prepare"},
+		ExpensiveRateLimit: 0.001,
+	})
+	require.NoError(t, err)
+
+	handler := sec.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string, apiKey string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if apiKey != "" {
+			req.Header.Set(APIKeyHeader, apiKey)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, serve("/checkpoints/latest", ""))
+	require.Equal(t, http.StatusUnauthorized, serve("/checkpoints/latest", "other"))
+	require.Equal(t, http.StatusOK, serve("/checkpoints/latest", "key"))
+
+	// the expensive routes have their own limit
+	require.Equal(t, http.StatusOK, serve("/checkpoints/prepare", "key"))
+	require.Equal(t, http.StatusTooManyRequests, serve("/checkpoints/prepare", "key"))
+	require.Equal(t, http.StatusOK, serve("/checkpoints/latest", "key"))
+}
+
+func TestRateLimitByHost(t *testing.T) {
+	t.Parallel()
+
+	sec, err := New(Config{
+		RateLimit:      0.001,
+		RateLimitBurst: 1,
+	})
+	require.NoError(t, err)
+
+	handler := sec.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/checkpoints/latest", nil)
+		req.RemoteAddr = remoteAddr
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	// the anonymous clients are limited by host, whatever the port of the connection
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:50001"))
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:50002"))
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:50003"))
+	require.Equal(t, http.StatusOK, serve("10.0.0.2:50001"))
+	require.Equal(t, http.StatusOK, serve("[2001:db8::1]:50001"))
+	require.Equal(t, http.StatusTooManyRequests, serve("[2001:db8::1]:50002"))
+
+	// the same for grpc
+	info := &grpc.UnaryServerInfo{FullMethod: "/heimdall.Heimdall/FetchCheckpoint"}
+	handle := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	call := func(port int) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.3"), Port: port}})
+		_, err := sec.unaryInterceptor(ctx, nil, info, handle)
+
+		return err
+	}
+
+	require.NoError(t, call(50001))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call(50002)))
+}
+
+func TestGRPCInterceptor(t *testing.T) {
+	t.Parallel()
+
+	sec, err := New(Config{
+		JWTSecret:      "secret",
+		RateLimit:      0.001,
+		RateLimitBurst: 1,
+	})
+	require.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/heimdall.Heimdall/FetchCheckpoint"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	_, err = sec.unaryInterceptor(context.Background(), nil, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	token := signJWT(t, "secret", "HS256", map[string]interface{}{"sub": "bor"})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadata, "Bearer "+token))
+
+	_, err = sec.unaryInterceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+
+	_, err = sec.unaryInterceptor(ctx, nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	config, err := NewTLSConfig("", "", "")
+	require.NoError(t, err)
+	require.Nil(t, config)
+
+	_, err = NewTLSConfig("", "", "ca.pem")
+	require.Error(t, err)
+
+	_, err = NewTLSConfig("missing.pem", "missing.key", "")
+	require.Error(t, err)
+}
+
+// writeTestCert writes a certificate of template signed by parent, self signed if parent is nil,
+// and its key to dir. It returns the certificate, its key and the files
+func writeTestCert(t *testing.T, dir string, name string, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".pem")
+	keyFile := filepath.Join(dir, name+".key")
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	return cert, key, certFile, keyFile
+}
+
+// TestHeimdallClientMTLS runs the requests of the heimdall REST client, used by the bridge and the
+// remaining REST backed gRPC methods, against a server with mTLS and rate limits
+func TestHeimdallClientMTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+
+	ca, caKey, caFile, _ := writeTestCert(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "heimdall test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+
+	// the server certificate is also a client certificate, as the node presents it to its own servers
+	_, _, serverCertFile, serverKeyFile := writeTestCert(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "heimdall"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	_, _, clientCertFile, clientKeyFile := writeTestCert(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "bridge"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	sec, err := New(Config{
+		TLSCertFile:     serverCertFile,
+		TLSKeyFile:      serverKeyFile,
+		TLSClientCAFile: caFile,
+		APIKeys:         []string{"key"},
+		RateLimit:       0.001,
+		RateLimitBurst:  2,
+	})
+	require.NoError(t, err)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &http.Server{
+		Handler: sec.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})),
+		ReadHeaderTimeout: time.Second,
+	}
+
+	go func() { _ = server.Serve(sec.Listener(ln)) }()
+
+	t.Cleanup(func() { server.Close() })
+
+	url := "https://" + ln.Addr().String() + "/checkpoints/count"
+
+	get := func(client *http.Client) (int, error) {
+		resp, err := client.Get(url)
+		if err != nil {
+			return 0, err
+		}
+
+		resp.Body.Close()
+
+		return resp.StatusCode, nil
+	}
+
+	// a client without certificate is rejected
+	tlsConfig, err := helper.NewHeimdallTLSConfig("", "", caFile)
+	require.NoError(t, err)
+
+	_, err = get(helper.NewHeimdallHTTPClient("key", tlsConfig))
+	require.Error(t, err)
+
+	// the client certificate and the node server certificate are accepted
+	tlsConfig, err = helper.NewHeimdallTLSConfig(clientCertFile, clientKeyFile, caFile)
+	require.NoError(t, err)
+
+	code, err := get(helper.NewHeimdallHTTPClient("key", tlsConfig))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	tlsConfig, err = helper.NewHeimdallTLSConfig(serverCertFile, serverKeyFile, caFile)
+	require.NoError(t, err)
+
+	client := helper.NewHeimdallHTTPClient("key", tlsConfig)
+
+	code, err = get(client)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	// the api key is sent along the certificate, the requests share its rate limit
+	code, err = get(client)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	_, err = helper.NewHeimdallTLSConfig(clientCertFile, "", "")
+	require.Error(t, err)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 var (
@@ -48,6 +50,8 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper upgrade.Keeper
 	// module communicator
 	moduleCommunicator ModuleCommunicator
 }
@@ -59,6 +63,7 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper upgrade.Keeper,
 	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
@@ -67,6 +72,7 @@ func NewKeeper(
 		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:          codespace,
 		chainKeeper:        chainKeeper,
+		upgradeKeeper:      upgradeKeeper,
 		moduleCommunicator: moduleCommunicator,
 	}
 
@@ -294,7 +300,7 @@ func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTy
 
 	//Hard fork changes for milestone
 	//When there is any update in checkpoint validator set, we assign it to milestone validator set too.
-	if ctx.BlockHeight() >= helper.GetAalborgHardForkHeight() {
+	if k.upgradeKeeper.IsUpgradeActive(ctx, upgradeTypes.Aalborg) {
 		store.Set(CurrentMilestoneValidatorSetKey, bz)
 	}
 
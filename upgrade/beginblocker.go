@@ -0,0 +1,26 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BeginBlocker applies the scheduled upgrade plan at its height. A binary without the handler of the
+// plan halts there, so that the node is restarted with a binary knowing the upgrade. A binary with the
+// handler can run before the height: the upgraded logic is switched by IsUpgradeActive
+func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found || !plan.ShouldExecute(ctx) {
+		return
+	}
+
+	if !k.HasUpgradeHandler(plan.Name) {
+		msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		k.Logger(ctx).Error(msg)
+		panic(msg)
+	}
+
+	k.ApplyUpgrade(ctx, plan)
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryCurrentPlan(cdc),
+			GetCmdQueryApplied(cdc),
+			GetCmdQueryStatus(cdc),
+		)...,
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryCurrentPlan implements the current upgrade plan query command.
+func GetCmdQueryCurrentPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Args:  cobra.NoArgs,
+		Short: "show the scheduled upgrade plan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent), nil)
+			if err != nil {
+				return err
+			}
+
+			var plan *types.Plan
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &plan); err != nil {
+				return err
+			}
+
+			if plan == nil {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the applied upgrades query command.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied",
+		Args:  cobra.NoArgs,
+		Short: "show the upgrades applied by a plan, with their height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied), nil)
+			if err != nil {
+				return err
+			}
+
+			var upgrades []types.AppliedUpgrade
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &upgrades); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(upgrades)
+		},
+	}
+}
+
+// GetCmdQueryStatus implements the upgrade status query command.
+func GetCmdQueryStatus(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show the first height of an upgrade, and whether it is active",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the first height of an upgrade, applied by a plan or at a height of the binary.
+
+Example:
+$ %s query upgrade status danelaw
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			data, err := jsoniter.ConfigFastest.Marshal(types.NewQueryStatusParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStatus), data)
+			if err != nil {
+				return err
+			}
+
+			var status types.UpgradeStatus
+			if err = jsoniter.ConfigFastest.Unmarshal(bz, &status); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(status)
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govCli "github.com/maticnetwork/heimdall/gov/client/cli"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+var logger = helper.Logger.With("module", "upgrade/client/cli")
+
+// Software upgrade proposal flags
+const (
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeInfo   = "upgrade-info"
+)
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a software
+// upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+The upgrade is applied at the upgrade height, where the nodes without the upgrade halt.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade v1.1.0 --upgrade-height=1000000 --upgrade-info="https://github.com/maticnetwork/heimdall/releases/tag/v1.1.0" --title="Upgrade v1.1.0" --description="..." --deposit="1000000000000000000matic" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			plan := types.NewPlan(args[0], viper.GetInt64(FlagUpgradeHeight), viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), plan)
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "height of the upgrade")
+	cmd.Flags().String(FlagUpgradeInfo, "", "release of the upgrade, such as the version or the download links")
+
+	if err := cmd.MarkFlagRequired(FlagUpgradeHeight); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagUpgradeHeight", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for submitting a cancel
+// software upgrade proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal cancelling the scheduled software upgrade",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			content := types.NewCancelSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription))
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// addProposalFlags adds the flags of the proposals
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govCli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of the proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of the proposal")
+	cmd.Flags().Int(govCli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	if err := cmd.MarkFlagRequired(govCli.FlagValidatorID); err != nil {
+		logger.Error("addProposalFlags | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+}
+
+// submitProposal broadcasts the proposal of content with the deposit of the flags
+func submitProposal(cliCtx context.CLIContext, content govTypes.Content) error {
+	deposit, err := sdk.ParseCoins(viper.GetString(govCli.FlagDeposit))
+	if err != nil {
+		return err
+	}
+
+	validatorID := viper.GetUint64(govCli.FlagValidatorID)
+	if validatorID == 0 {
+		return fmt.Errorf("Valid validator ID required")
+	}
+
+	from := helper.GetFromAddress(cliCtx)
+
+	msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+}
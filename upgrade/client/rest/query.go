@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// HTTP request handler to query the scheduled upgrade plan, null if none
+func currentPlanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryHandlerFn(cliCtx, types.QueryCurrent, func(r *http.Request) ([]byte, error) {
+		return nil, nil
+	})
+}
+
+// HTTP request handler to query the upgrades applied by a plan
+func appliedUpgradesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryHandlerFn(cliCtx, types.QueryApplied, func(r *http.Request) ([]byte, error) {
+		return nil, nil
+	})
+}
+
+// HTTP request handler to query the first height of an upgrade, and whether it is active
+func upgradeStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryHandlerFn(cliCtx, types.QueryStatus, func(r *http.Request) ([]byte, error) {
+		return jsoniter.ConfigFastest.Marshal(types.NewQueryStatusParams(mux.Vars(r)[RestUpgradeName]))
+	})
+}
+
+// queryHandlerFn queries the upgrade querier at path, with the data of the request
+func queryHandlerFn(cliCtx context.CLIContext, path string, data func(r *http.Request) ([]byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := data(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+// RestUpgradeName is the path variable of the upgrade name
+const RestUpgradeName = "name"
+
+// RegisterRoutes registers the upgrade module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/upgrade/current", currentPlanHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/upgrade/applied", appliedUpgradesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/upgrade/status/{%s}", RestUpgradeName), upgradeStatusHandlerFn(cliCtx)).Methods("GET")
+}
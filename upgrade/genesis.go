@@ -0,0 +1,33 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// InitGenesis sets the upgrade plan and the applied upgrades for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	for _, upgrade := range data.Applied {
+		keeper.SetAppliedUpgrade(ctx, upgrade.Name, upgrade.Height)
+	}
+
+	if data.Plan != nil {
+		if err := keeper.ScheduleUpgrade(ctx, *data.Plan); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	var plan *types.Plan
+	if p, found := keeper.GetUpgradePlan(ctx); found {
+		plan = &p
+	}
+
+	return types.NewGenesisState(
+		plan,
+		keeper.GetAppliedUpgrades(ctx),
+	)
+}
@@ -0,0 +1,181 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// Keeper of the upgrade module. It schedules the upgrade plans passed by governance, and records the
+// height of the applied ones
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) key of the gov store, which keeps the upgrade state
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// handlers of the upgrades known to the binary
+	upgradeHandlers map[string]types.UpgradeHandler
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+) Keeper {
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		codespace:       codespace,
+		upgradeHandlers: make(map[string]types.UpgradeHandler),
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetUpgradeHandler sets the handler of the upgrade name, which makes the upgrade known to the binary.
+// The handlers must be set before the first block, and kept once applied for the nodes syncing the chain
+func (k Keeper) SetUpgradeHandler(name string, handler types.UpgradeHandler) {
+	k.upgradeHandlers[name] = handler
+}
+
+// HasUpgradeHandler returns true if the upgrade name is known to the binary
+func (k Keeper) HasUpgradeHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// -----------------------------------------------------------------------------
+// Plan
+
+// ScheduleUpgrade schedules an upgrade plan, replacing the plan scheduled before if any
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidPlan(k.codespace, fmt.Sprintf("height %d is not after the current height %d", plan.Height, ctx.BlockHeight()))
+	}
+
+	if height, ok := k.GetUpgradeHeight(ctx, plan.Name); ok {
+		return types.ErrAlreadyApplied(k.codespace, plan.Name, height)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+
+	return nil
+}
+
+// GetUpgradePlan returns the scheduled upgrade plan
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+
+	return plan, true
+}
+
+// ClearUpgradePlan removes the scheduled upgrade plan
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey)
+}
+
+// ApplyUpgrade runs the handler of the plan and records the upgrade as applied at the ctx height.
+// It panics if the handler fails, as the chain cannot go on without the migrations of the upgrade
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		panic(fmt.Sprintf("no handler for upgrade %s", plan.Name))
+	}
+
+	if err := handler(ctx, plan); err != nil {
+		panic(fmt.Sprintf("unable to apply upgrade %s: %s", plan.Name, err))
+	}
+
+	k.SetAppliedUpgrade(ctx, plan.Name, ctx.BlockHeight())
+	k.ClearUpgradePlan(ctx)
+
+	k.Logger(ctx).Info("Applied upgrade", "name", plan.Name, "height", ctx.BlockHeight())
+}
+
+// -----------------------------------------------------------------------------
+// Applied upgrades
+
+// SetAppliedUpgrade records the upgrade name as applied at height
+func (k Keeper) SetAppliedUpgrade(ctx sdk.Context, name string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DoneKey(name), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetAppliedHeight returns the height at which the upgrade name was applied by a plan. The read is not
+// charged to the gas meter of ctx, as the upgrades are checked by the ante handler and the msg handlers
+// of every tx
+func (k Keeper) GetAppliedHeight(ctx sdk.Context, name string) (int64, bool) {
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+
+	bz := store.Get(types.DoneKey(name))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// GetAppliedUpgrades returns the upgrades applied by a plan
+func (k Keeper) GetAppliedUpgrades(ctx sdk.Context) (upgrades []types.AppliedUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DoneKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		upgrades = append(upgrades, types.AppliedUpgrade{
+			Name:   string(iterator.Key()[len(types.DoneKeyPrefix):]),
+			Height: int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+
+	return upgrades
+}
+
+// GetUpgradeHeight returns the first height of the upgrade name: the height of its plan once applied,
+// or the height of the binary for the hard forks before the upgrade module
+func (k Keeper) GetUpgradeHeight(ctx sdk.Context, name string) (int64, bool) {
+	if height, ok := k.GetAppliedHeight(ctx, name); ok {
+		return height, true
+	}
+
+	if legacyHeight, ok := legacyUpgrades[name]; ok {
+		return legacyHeight()
+	}
+
+	return 0, false
+}
+
+// IsUpgradeActive returns true if the upgrade name is active at the ctx height
+func (k Keeper) IsUpgradeActive(ctx sdk.Context, name string) bool {
+	height, ok := k.GetUpgradeHeight(ctx, name)
+
+	return ok && ctx.BlockHeight() >= height
+}
@@ -0,0 +1,205 @@
+package upgrade_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type testInput struct {
+	ctx    sdk.Context
+	keeper upgrade.Keeper
+}
+
+func newTestInput(t *testing.T) testInput {
+	t.Helper()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+
+	keyGov := sdk.NewKVStoreKey(govTypes.StoreKey)
+	cms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
+
+	require.NoError(t, cms.LoadLatestVersion())
+
+	keeper := upgrade.NewKeeper(cdc, keyGov, types.DefaultCodespace)
+	ctx := sdk.NewContext(cms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	return testInput{ctx, keeper}
+}
+
+func noopHandler(_ sdk.Context, _ types.Plan) error { return nil }
+
+func TestScheduleUpgrade(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// invalid plans
+	require.Error(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("", 20, "")))
+	require.Error(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v2", 0, "")))
+	require.Error(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v2", 10, "")), "height not after the current one")
+
+	// the hard forks at a height of the binary are already active
+	require.Error(t, keeper.ScheduleUpgrade(ctx, types.NewPlan(types.Jorvik, 20, "")))
+
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v2", 20, "")))
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v3", 30, "info")))
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, types.NewPlan("v3", 30, "info"), plan, "the last plan replaces the previous one")
+
+	keeper.ClearUpgradePlan(ctx)
+
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestIsUpgradeActive(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	// legacy heights of the default chain
+	require.True(t, keeper.IsUpgradeActive(ctx, types.Aalborg))
+	require.True(t, keeper.IsUpgradeActive(ctx, types.Danelaw))
+	require.True(t, keeper.IsUpgradeActive(ctx, types.UpgradeProposals))
+
+	height, ok := keeper.GetUpgradeHeight(ctx, types.NewHexToStringAlgo)
+	require.True(t, ok)
+	require.Equal(t, int64(1), height, "active after the height of the binary")
+
+	// unknown upgrade
+	require.False(t, keeper.IsUpgradeActive(ctx, "v2"))
+
+	keeper.SetAppliedUpgrade(ctx, "v2", 20)
+	require.False(t, keeper.IsUpgradeActive(ctx, "v2"))
+	require.True(t, keeper.IsUpgradeActive(ctx.WithBlockHeight(20), "v2"))
+
+	// the checks are not charged to the tx gas
+	gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(100000))
+	require.False(t, keeper.IsUpgradeActive(gasCtx, "v2"))
+	require.Zero(t, gasCtx.GasMeter().GasConsumed())
+
+	require.Equal(t, []types.AppliedUpgrade{{Name: "v2", Height: 20}}, keeper.GetAppliedUpgrades(ctx))
+	require.Error(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v2", 30, "")), "already applied")
+}
+
+func TestBeginBlocker(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v2", 20, "")))
+
+	// unknown to the binary, halts at the height
+	require.NotPanics(t, func() { upgrade.BeginBlocker(ctx.WithBlockHeight(19), abci.RequestBeginBlock{}, keeper) })
+	require.Panics(t, func() { upgrade.BeginBlocker(ctx.WithBlockHeight(20), abci.RequestBeginBlock{}, keeper) })
+
+	// known, applied once at the height
+	applied := 0
+
+	keeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan types.Plan) error {
+		applied++
+		return nil
+	})
+
+	upgrade.BeginBlocker(ctx.WithBlockHeight(19), abci.RequestBeginBlock{}, keeper)
+	require.Equal(t, 0, applied)
+	require.False(t, keeper.IsUpgradeActive(ctx.WithBlockHeight(19), "v2"))
+
+	upgrade.BeginBlocker(ctx.WithBlockHeight(20), abci.RequestBeginBlock{}, keeper)
+	upgrade.BeginBlocker(ctx.WithBlockHeight(21), abci.RequestBeginBlock{}, keeper)
+	require.Equal(t, 1, applied)
+	require.True(t, keeper.IsUpgradeActive(ctx.WithBlockHeight(20), "v2"))
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// a failing handler halts the chain
+	keeper.SetUpgradeHandler("v3", func(ctx sdk.Context, plan types.Plan) error {
+		return errors.New("migration failed")
+	})
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v3", 30, "")))
+	require.Panics(t, func() { upgrade.BeginBlocker(ctx.WithBlockHeight(30), abci.RequestBeginBlock{}, keeper) })
+}
+
+func TestProposalHandler(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+	handler := upgrade.NewSoftwareUpgradeProposalHandler(keeper)
+
+	proposal := types.NewSoftwareUpgradeProposal("Upgrade", "description", types.NewPlan("v2", 20, ""))
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, handler(ctx, proposal))
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, proposal.Plan, plan)
+
+	// a plan in the past fails the proposal
+	require.Error(t, handler(ctx.WithBlockHeight(30), proposal))
+
+	require.NoError(t, handler(ctx, types.NewCancelSoftwareUpgradeProposal("Cancel", "description")))
+
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	require.Error(t, types.NewSoftwareUpgradeProposal("Upgrade", "description", types.NewPlan("", 20, "")).ValidateBasic())
+}
+
+func TestGenesis(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	keeper.SetUpgradeHandler("v2", noopHandler)
+	keeper.SetAppliedUpgrade(ctx, "v1", 5)
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, types.NewPlan("v2", 20, "")))
+
+	genesis := upgrade.ExportGenesis(ctx, keeper)
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	imported := newTestInput(t)
+	upgrade.InitGenesis(imported.ctx, imported.keeper, genesis)
+	require.Equal(t, genesis, upgrade.ExportGenesis(imported.ctx, imported.keeper))
+
+	genesis.Applied = append(genesis.Applied, genesis.Applied[0])
+	require.Error(t, types.ValidateGenesis(genesis))
+
+	require.NoError(t, upgrade.AppModuleBasic{}.ValidateGenesis(nil), "genesis without upgrade state")
+
+	defaultGenesis := types.DefaultGenesisState()
+	require.NoError(t, types.ValidateGenesis(defaultGenesis))
+
+	devnet := newTestInput(t)
+	upgrade.InitGenesis(devnet.ctx, devnet.keeper, defaultGenesis)
+
+	for _, name := range types.GenesisUpgrades {
+		require.True(t, devnet.keeper.IsUpgradeActive(devnet.ctx.WithBlockHeight(0), name), name)
+	}
+}
@@ -0,0 +1,31 @@
+package upgrade
+
+import (
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// legacyUpgrades returns the first height of the hard forks activated at a height of the binary for each
+// chain, before the upgrade module. The heights keep the comparisons of the former checks: a negative
+// height activates the fork from genesis, except for the forks only activated by a plan on the chain
+var legacyUpgrades = map[string]func() (int64, bool){
+	types.NewSelectionAlgo: func() (int64, bool) { return helper.GetNewSelectionAlgoHeight(), true },
+	// the spans are overridden at the override height, the event data checks change after it
+	types.SpanOverride:       func() (int64, bool) { return helper.GetSpanOverrideHeight() + 1, true },
+	types.NewHexToStringAlgo: func() (int64, bool) { return helper.GetNewHexToStringAlgoHeight() + 1, true },
+	types.Aalborg:            func() (int64, bool) { return helper.GetAalborgHardForkHeight(), true },
+	types.Jorvik:             func() (int64, bool) { return helper.GetJorvikHeight(), true },
+	types.Danelaw:            func() (int64, bool) { return helper.GetDanelawHeight(), true },
+	types.MilestoneParams:    planOnly(helper.GetMilestoneParamsHeight),
+	types.CheckpointIndex:    planOnly(helper.GetCheckpointIndexHeight),
+	// the plans are scheduled by the upgrade proposals, only the height of the binary activates them
+	types.UpgradeProposals: planOnly(helper.GetUpgradeProposalsHeight),
+}
+
+// planOnly returns the height of a fork activated by a plan on the chains with a negative height
+func planOnly(getHeight func() int64) func() (int64, bool) {
+	return func() (int64, bool) {
+		height := getHeight()
+		return height, height >= 0
+	}
+}
@@ -0,0 +1,48 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewSoftwareUpgradeProposalHandler new software upgrade proposal handler. The proposals are rejected, on
+// submit and once passed, before the upgrade proposals height of the binary
+func NewSoftwareUpgradeProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		if !k.IsUpgradeActive(ctx, types.UpgradeProposals) {
+			return govTypes.ErrInactiveProposalType(k.codespace, content.ProposalType(), types.UpgradeProposals)
+		}
+
+		switch c := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.SoftwareUpgradeProposal) sdk.Error {
+	k.Logger(ctx).Info("Scheduling upgrade", "name", p.Plan.Name, "height", p.Plan.Height, "info", p.Plan.Info)
+
+	return k.ScheduleUpgrade(ctx, p.Plan)
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, _ types.CancelSoftwareUpgradeProposal) sdk.Error {
+	if plan, found := k.GetUpgradePlan(ctx); found {
+		k.Logger(ctx).Info("Cancelling upgrade", "name", plan.Name, "height", plan.Height)
+	}
+
+	k.ClearUpgradePlan(ctx)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	jsoniter "github.com/json-iterator/go"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewQuerier creates a querier for upgrade REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryCurrent:
+			return queryCurrent(ctx, req, keeper)
+		case types.QueryApplied:
+			return queryApplied(ctx, req, keeper)
+		case types.QueryStatus:
+			return queryStatus(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryCurrent(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var result *types.Plan
+	if plan, found := keeper.GetUpgradePlan(ctx); found {
+		result = &plan
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryApplied(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	upgrades := keeper.GetAppliedUpgrades(ctx)
+	if upgrades == nil {
+		upgrades = []types.AppliedUpgrade{}
+	}
+
+	bz, err := jsoniter.ConfigFastest.Marshal(upgrades)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStatusParams
+	if err := jsoniter.ConfigFastest.Unmarshal(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	height, _ := keeper.GetUpgradeHeight(ctx, params.Name)
+
+	bz, err := jsoniter.ConfigFastest.Marshal(types.UpgradeStatus{
+		Name:   params.Name,
+		Height: height,
+		Active: keeper.IsUpgradeActive(ctx, params.Name),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = "upgrade"
+
+	CodeInvalidPlan    sdk.CodeType = 1
+	CodeAlreadyApplied sdk.CodeType = 2
+)
+
+// ErrInvalidPlan returns an error for an invalid upgrade plan.
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+// ErrAlreadyApplied returns an error for an upgrade already applied.
+func ErrAlreadyApplied(codespace sdk.CodespaceType, name string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyApplied, fmt.Sprintf("upgrade %s already applied at height %d", name, height))
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all upgrade state that must be provided at genesis
+type GenesisState struct {
+	Plan    *Plan            `json:"plan" yaml:"plan"`
+	Applied []AppliedUpgrade `json:"applied" yaml:"applied"`
+}
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(plan *Plan, applied []AppliedUpgrade) GenesisState {
+	return GenesisState{
+		Plan:    plan,
+		Applied: applied,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state, with the genesis upgrades applied at height 0
+func DefaultGenesisState() GenesisState {
+	applied := make([]AppliedUpgrade, 0, len(GenesisUpgrades))
+	for _, name := range GenesisUpgrades {
+		applied = append(applied, AppliedUpgrade{Name: name, Height: 0})
+	}
+
+	return NewGenesisState(nil, applied)
+}
+
+// ValidateGenesis performs basic validation of upgrade genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	names := make(map[string]bool)
+
+	for _, upgrade := range data.Applied {
+		if upgrade.Name == "" || upgrade.Height < 0 {
+			return fmt.Errorf("invalid applied upgrade %s at height %d", upgrade.Name, upgrade.Height)
+		}
+
+		if names[upgrade.Name] {
+			return fmt.Errorf("duplicate applied upgrade %s", upgrade.Name)
+		}
+
+		names[upgrade.Name] = true
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns upgrade GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
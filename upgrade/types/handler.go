@@ -0,0 +1,9 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler applies an upgrade at the height of its plan, such as the migrations of the stores. An
+// error halts the chain
+type UpgradeHandler func(ctx sdk.Context, plan Plan) error
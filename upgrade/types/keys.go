@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "upgrade"
+
+	// RouterKey is the proposal route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+// Keys for the upgrade state. The module has no store of its own, mounting a new store would change
+// the app hash of every block: the state is kept in the gov store, under prefixes not used by gov
+//
+// - 0x40: Plan
+//
+// - 0x41<name_Bytes>: height of the applied upgrade
+var (
+	PlanKey       = []byte{0x40}
+	DoneKeyPrefix = []byte{0x41}
+)
+
+// DoneKey returns the key of the height of an applied upgrade
+func DoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan schedules the upgrade name at a height. From the height, the binaries without the upgrade
+// handler of name halt, and the others apply the upgrade
+type Plan struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+	Info   string `json:"info" yaml:"info"` // release of the upgrade, such as the version or the download links
+}
+
+// NewPlan creates a new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic validates the plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+
+	if p.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height must be greater than 0")
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the plan is due at the ctx height
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && ctx.BlockHeight() >= p.Height
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// AppliedUpgrade is an upgrade applied at a height
+type AppliedUpgrade struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+// UpgradeStatus is the activation of an upgrade at the query height. Height is the first height of the
+// upgrade, from its plan or from the binary for the hard forks before the upgrade module
+type UpgradeStatus struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+	Active bool   `json:"active" yaml:"active"`
+}
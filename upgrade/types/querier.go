@@ -0,0 +1,18 @@
+package types
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+	QueryStatus  = "status"
+)
+
+// QueryStatusParams defines the params for querying the status of an upgrade
+type QueryStatusParams struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// NewQueryStatusParams creates a new instance of QueryStatusParams.
+func NewQueryStatusParams(name string) QueryStatusParams {
+	return QueryStatusParams{Name: name}
+}
@@ -0,0 +1,28 @@
+package types
+
+// Names of the upgrades. The hard forks activated before the upgrade module keep the heights baked
+// in the binary for each chain, the later ones are activated by a software upgrade proposal
+const (
+	NewSelectionAlgo     = "newSelectionAlgo"
+	SpanOverride         = "spanOverride"
+	NewHexToStringAlgo   = "newHexToStringAlgo"
+	Aalborg              = "aalborg"
+	Jorvik               = "jorvik"
+	Danelaw              = "danelaw"
+	MilestoneParams      = "milestoneParams"
+	CheckpointIndex      = "checkpointIndex"
+	VoteArchive          = "voteArchive"
+	ProducerSelection    = "producerSelection"
+	GovProposalTypes     = "govProposalTypes"
+	ChainParamsMigration = "chainParamsMigration"
+	UpgradeProposals     = "upgradeProposals"
+)
+
+// GenesisUpgrades are the upgrades applied at genesis by the default genesis state, the new chains
+// start with them active while the existing chains activate them with a plan
+var GenesisUpgrades = []string{
+	VoteArchive,
+	ProducerSelection,
+	GovProposalTypes,
+	ChainParamsMigration,
+}